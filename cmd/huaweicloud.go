@@ -1,18 +1,31 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	kitlog "github.com/go-kit/kit/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 
 	"github.com/cycloidio/terracognita/huaweicloud"
 	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/tag"
 )
 
 var (
 	huaweicloudTags []string
+	huaweicloudOnly []string
+
+	huaweicloudStatuses []string
 
 	huaweicloudCmd = &cobra.Command{
 		Use:   "huaweicloud",
@@ -28,6 +41,36 @@ var (
 			viper.BindPFlag("huaweicloud-security-token", cmd.Flags().Lookup("huaweicloud-security-token"))
 			viper.BindPFlag("huaweicloud-region", cmd.Flags().Lookup("huaweicloud-region"))
 			viper.BindPFlag("huaweicloud-project-id", cmd.Flags().Lookup("huaweicloud-project-id"))
+			viper.BindPFlag("huaweicloud-tags-missing", cmd.Flags().Lookup("huaweicloud-tags-missing"))
+			viper.BindPFlag("huaweicloud-validate-flavors", cmd.Flags().Lookup("huaweicloud-validate-flavors"))
+			viper.BindPFlag("huaweicloud-proxy", cmd.Flags().Lookup("huaweicloud-proxy"))
+			viper.BindPFlag("huaweicloud-insecure", cmd.Flags().Lookup("huaweicloud-insecure"))
+			viper.BindPFlag("huaweicloud-drs-include-finished", cmd.Flags().Lookup("huaweicloud-drs-include-finished"))
+			viper.BindPFlag("huaweicloud-include-defaults", cmd.Flags().Lookup("huaweicloud-include-defaults"))
+			viper.BindPFlag("huaweicloud-plugin-cache-dir", cmd.Flags().Lookup("huaweicloud-plugin-cache-dir"))
+			viper.BindPFlag("huaweicloud-enterprise-project-id", cmd.Flags().Lookup("huaweicloud-enterprise-project-id"))
+			viper.BindPFlag("huaweicloud-all-enterprise-projects", cmd.Flags().Lookup("huaweicloud-all-enterprise-projects"))
+			viper.BindPFlag("huaweicloud-resource-group-by-tag", cmd.Flags().Lookup("huaweicloud-resource-group-by-tag"))
+			viper.BindPFlag("huaweicloud-max-concurrency", cmd.Flags().Lookup("huaweicloud-max-concurrency"))
+			viper.BindPFlag("huaweicloud-parallel-types-only", cmd.Flags().Lookup("huaweicloud-parallel-types-only"))
+			viper.BindPFlag("huaweicloud-rms", cmd.Flags().Lookup("huaweicloud-rms"))
+			viper.BindPFlag("huaweicloud-resourceql", cmd.Flags().Lookup("huaweicloud-resourceql"))
+			viper.BindPFlag("huaweicloud-resources-file", cmd.Flags().Lookup("huaweicloud-resources-file"))
+			viper.BindPFlag("huaweicloud-cycloid-project", cmd.Flags().Lookup("huaweicloud-cycloid-project"))
+			viper.BindPFlag("huaweicloud-timings", cmd.Flags().Lookup("huaweicloud-timings"))
+			viper.BindPFlag("huaweicloud-verbose-reader-errors", cmd.Flags().Lookup("huaweicloud-verbose-reader-errors"))
+			viper.BindPFlag("huaweicloud-validate-hcl", cmd.Flags().Lookup("huaweicloud-validate-hcl"))
+			viper.BindPFlag("huaweicloud-created-after", cmd.Flags().Lookup("huaweicloud-created-after"))
+			viper.BindPFlag("huaweicloud-tag-transform", cmd.Flags().Lookup("huaweicloud-tag-transform"))
+			viper.BindPFlag("huaweicloud-exclude-without-creation-time", cmd.Flags().Lookup("huaweicloud-exclude-without-creation-time"))
+			viper.BindPFlag("huaweicloud-charging-mode", cmd.Flags().Lookup("huaweicloud-charging-mode"))
+			viper.BindPFlag("huaweicloud-inline-data-disks", cmd.Flags().Lookup("huaweicloud-inline-data-disks"))
+			viper.BindPFlag("huaweicloud-inline-eips", cmd.Flags().Lookup("huaweicloud-inline-eips"))
+			viper.BindPFlag("huaweicloud-pin-dhcp-ips", cmd.Flags().Lookup("huaweicloud-pin-dhcp-ips"))
+			viper.BindPFlag("huaweicloud-rds-read-replicas", cmd.Flags().Lookup("huaweicloud-rds-read-replicas"))
+			viper.BindPFlag("huaweicloud-output-module", cmd.Flags().Lookup("huaweicloud-output-module"))
+			viper.BindPFlag("huaweicloud-catalog-file", cmd.Flags().Lookup("huaweicloud-catalog-file"))
+			viper.BindPFlag("huaweicloud-region-from-catalog", cmd.Flags().Lookup("huaweicloud-region-from-catalog"))
 			viper.BindPFlag("tags", cmd.Flags().Lookup("tags"))
 
 			viper.RegisterAlias("access-key", "huaweicloud-access-key")
@@ -35,6 +78,40 @@ var (
 			viper.RegisterAlias("security-token", "huaweicloud-security-token")
 			viper.RegisterAlias("region", "huaweicloud-region")
 			viper.RegisterAlias("project-id", "huaweicloud-project-id")
+			viper.RegisterAlias("tags-missing", "huaweicloud-tags-missing")
+			viper.RegisterAlias("validate-flavors", "huaweicloud-validate-flavors")
+			viper.RegisterAlias("proxy", "huaweicloud-proxy")
+			viper.RegisterAlias("insecure", "huaweicloud-insecure")
+			viper.RegisterAlias("drs-include-finished", "huaweicloud-drs-include-finished")
+			viper.RegisterAlias("include-defaults", "huaweicloud-include-defaults")
+			viper.RegisterAlias("plugin-cache-dir", "huaweicloud-plugin-cache-dir")
+			viper.RegisterAlias("enterprise-project-id", "huaweicloud-enterprise-project-id")
+			viper.RegisterAlias("all-enterprise-projects", "huaweicloud-all-enterprise-projects")
+			viper.RegisterAlias("resource-group-by-tag", "huaweicloud-resource-group-by-tag")
+			viper.RegisterAlias("max-concurrency", "huaweicloud-max-concurrency")
+			viper.RegisterAlias("parallel-types-only", "huaweicloud-parallel-types-only")
+			viper.RegisterAlias("rms", "huaweicloud-rms")
+			viper.RegisterAlias("resourceql", "huaweicloud-resourceql")
+			viper.RegisterAlias("resources-file", "huaweicloud-resources-file")
+			viper.RegisterAlias("cycloid-project", "huaweicloud-cycloid-project")
+			viper.RegisterAlias("timings", "huaweicloud-timings")
+			viper.RegisterAlias("verbose-reader-errors", "huaweicloud-verbose-reader-errors")
+			viper.RegisterAlias("validate-hcl", "huaweicloud-validate-hcl")
+			viper.RegisterAlias("created-after", "huaweicloud-created-after")
+			viper.RegisterAlias("tag-transform", "huaweicloud-tag-transform")
+			viper.RegisterAlias("exclude-without-creation-time", "huaweicloud-exclude-without-creation-time")
+			viper.RegisterAlias("charging-mode", "huaweicloud-charging-mode")
+			viper.RegisterAlias("inline-data-disks", "huaweicloud-inline-data-disks")
+			viper.RegisterAlias("inline-eips", "huaweicloud-inline-eips")
+			viper.RegisterAlias("pin-dhcp-ips", "huaweicloud-pin-dhcp-ips")
+			viper.RegisterAlias("rds-read-replicas", "huaweicloud-rds-read-replicas")
+			viper.RegisterAlias("output-module", "huaweicloud-output-module")
+			viper.RegisterAlias("catalog-file", "huaweicloud-catalog-file")
+			viper.RegisterAlias("region-from-catalog", "huaweicloud-region-from-catalog")
+
+			if err := huaweicloudOutputModule(viper.GetString("output-module")); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -43,7 +120,11 @@ var (
 			logger := log.Get()
 			logger = kitlog.With(logger, "func", "cmd.huaweicloud.RunE")
 
-			if err := requiredStringFlags("access-key", "secret-key", "region", "project-id"); err != nil {
+			required := []string{"access-key", "secret-key", "region", "project-id"}
+			if viper.GetBool("region-from-catalog") {
+				required = required[:2]
+			}
+			if err := requiredStringFlags(required...); err != nil {
 				return err
 			}
 
@@ -52,15 +133,77 @@ var (
 				return err
 			}
 
+			if cp := viper.GetString("cycloid-project"); cp != "" {
+				tags, err = huaweicloudCycloidTags(tags, cp)
+				if err != nil {
+					return err
+				}
+			}
+
+			exclude, err = huaweicloudResourceTypes("exclude", exclude)
+			if err != nil {
+				return err
+			}
+
+			targets, err = huaweicloudTargets(targets)
+			if err != nil {
+				return err
+			}
+
+			if len(huaweicloudOnly) != 0 {
+				include, err = huaweicloudOnlyInclude(huaweicloudOnly, exclude)
+				if err != nil {
+					return err
+				}
+			}
+
+			opts, err := huaweicloudOptions()
+			if err != nil {
+				return err
+			}
+
+			if rf := viper.GetString("resources-file"); rf != "" {
+				if len(huaweicloudOnly) != 0 {
+					return fmt.Errorf("the flags --huaweicloud-resources-file and --huaweicloud-only are mutually exclusive")
+				}
+
+				include, opts.TypeFilters, err = huaweicloudResourcesFile(rf, exclude)
+				if err != nil {
+					return err
+				}
+			}
+
 			ctx := context.Background()
 
+			region, projectID := viper.GetString("region"), viper.GetString("project-id")
+			if viper.GetBool("region-from-catalog") {
+				regions, err := huaweicloud.DiscoverRegions(
+					ctx,
+					region,
+					viper.GetString("access-key"),
+					viper.GetString("secret-key"),
+					viper.GetString("security-token"),
+					opts,
+				)
+				if err != nil {
+					return err
+				}
+
+				region, projectID, err = huaweicloudCatalogRegion(regions, region, projectID)
+				if err != nil {
+					return err
+				}
+				logger.Log("msg", "region discovered", "region", region, "project-id", projectID)
+			}
+
 			provider, err := huaweicloud.NewProvider(
 				ctx,
-				viper.GetString("region"),
-				viper.GetString("project-id"),
+				region,
+				projectID,
 				viper.GetString("access-key"),
 				viper.GetString("secret-key"),
 				viper.GetString("security-token"),
+				opts,
 			)
 			if err != nil {
 				return err
@@ -77,12 +220,431 @@ var (
 
 func init() {
 	huaweicloudCmd.AddCommand(huaweicloudResourcesCmd)
+	huaweicloudCmd.AddCommand(huaweicloudSchemaCmd)
 
 	huaweicloudCmd.Flags().String("huaweicloud-access-key", "", "Access Key (required)")
 	huaweicloudCmd.Flags().String("huaweicloud-secret-key", "", "Secret Key (required)")
 	huaweicloudCmd.Flags().String("huaweicloud-security-token", "", "Security Token for temporary credentials")
 	huaweicloudCmd.Flags().String("huaweicloud-region", "", "Region to search in (required)")
 	huaweicloudCmd.Flags().String("huaweicloud-project-id", "", "Project ID scope for API calls (required)")
+	huaweicloudCmd.Flags().Bool("huaweicloud-region-from-catalog", false, "Discover the regions the credentials can access from their IAM projects, so the --huaweicloud-region and --huaweicloud-project-id are not required. Only one region is imported per run, as each one has its own provider configuration and state, so if they can access more than one region the --huaweicloud-region has to be one of them")
+
+	huaweicloudCmd.Flags().String("huaweicloud-tags-missing", "", "Only import the resources without tags, or without the tag KEY if used as '--huaweicloud-tags-missing=KEY'")
+	huaweicloudCmd.Flags().Lookup("huaweicloud-tags-missing").NoOptDefVal = huaweicloud.TagsMissingAny
+
+	huaweicloudCmd.Flags().String("huaweicloud-validate-flavors", "", fmt.Sprintf("Validate the flavor of the ECS and RDS instances against the available flavors, the unavailable ones are warned (%q) or substituted with the nearest available flavor (%q, ECS only)", huaweicloud.FlavorValidationWarn, huaweicloud.FlavorValidationSubstitute))
+	huaweicloudCmd.Flags().Lookup("huaweicloud-validate-flavors").NoOptDefVal = string(huaweicloud.FlavorValidationWarn)
+	huaweicloudCmd.Flags().Bool("huaweicloud-validate-hcl", false, "Validate the HCL of each resource against the schema of the embedded provider, the resources that do not satisfy it (ex: a required attribute that can not be read) are still written and listed at the end of the import")
+
+	huaweicloudCmd.Flags().String("huaweicloud-proxy", "", "HTTP(S) proxy URL used for the API calls, by default the HTTPS_PROXY, HTTP_PROXY and NO_PROXY env variables are used")
+	huaweicloudCmd.Flags().Bool("huaweicloud-insecure", false, "Disable the TLS verification, only for endpoints with self-signed certificates (ex: HCS)")
+	huaweicloudCmd.Flags().String("huaweicloud-catalog-file", "", "JSON file with the custom catalog of the regions and the endpoints of their services (ex: Huawei Cloud Stack on-premises), more information on the docs of the provider")
+
+	huaweicloudCmd.Flags().Bool("huaweicloud-drs-include-finished", false, "Import also the DRS jobs that are completed or deleted")
+
+	huaweicloudCmd.Flags().Bool("huaweicloud-rds-read-replicas", false, "Import the read replicas of the RDS instances as huaweicloud_rds_read_replica_instance referencing their primary instance, without it they are not imported as they can not be a huaweicloud_rds_instance")
+
+	huaweicloudCmd.Flags().Bool("huaweicloud-include-defaults", false, "Import also the resources created by default by Huawei Cloud (ex: the 'default' security group)")
+
+	huaweicloudCmd.Flags().String("huaweicloud-enterprise-project-id", "", "Only import the resources of the enterprise project, only the huaweicloud_compute_instance, huaweicloud_vpc, huaweicloud_vpc_eip and huaweicloud_networking_secgroup support it and the other types can not be imported")
+	huaweicloudCmd.Flags().Bool("huaweicloud-all-enterprise-projects", false, "Import the resources of each one of the enterprise projects the caller can see, with the same types as --huaweicloud-enterprise-project-id. It can not be used with --huaweicloud-enterprise-project-id")
+
+	huaweicloudCmd.Flags().Bool("huaweicloud-inline-data-disks", false, "Import the data disks attached to the ECS instances as the 'data_disks' of them instead of as huaweicloud_evs_volume, the disks shared by more than one instance are not inlined")
+	huaweicloudCmd.Flags().Bool("huaweicloud-inline-eips", false, "Import the EIP bound to the ECS instances as the 'eip_id' of them instead of as huaweicloud_vpc_eip_associate, the instances with more than one EIP are not inlined")
+	huaweicloudCmd.Flags().Bool("huaweicloud-pin-dhcp-ips", false, "Keep the 'fixed_ip_v4' of the NICs of the ECS instances on subnets with DHCP enabled, by default only the IPs of the subnets without DHCP (assigned manually) are kept")
+
+	huaweicloudCmd.Flags().String("huaweicloud-charging-mode", "", fmt.Sprintf("Only import the billable resources (ECS, RDS, EVS and EIP) with the charging mode, %q (yearly/monthly), %q (pay-per-use) or %q (ECS spot instances)", huaweicloud.ChargingModePrePaid, huaweicloud.ChargingModePostPaid, huaweicloud.ChargingModeSpot))
+
+	huaweicloudCmd.Flags().String("huaweicloud-plugin-cache-dir", "", "Terraform plugin cache directory (TF_PLUGIN_CACHE_DIR) used by the embedded provider, it must exist and be writable")
+
+	huaweicloudCmd.Flags().StringSliceVar(&huaweicloudOnly, "huaweicloud-only", []string{}, "List of the only resources to import (ex: huaweicloud_compute_instance or the short name compute_instance or alias ecs), it can not be used with --exclude")
+	huaweicloudCmd.Flags().String("huaweicloud-resources-file", "", "YAML or JSON file with the resource types to import and the filters of each one of them (tags, name regexp and max), it can not be used with --exclude nor --huaweicloud-only")
+	huaweicloudCmd.Flags().String("huaweicloud-cycloid-project", "", fmt.Sprintf("Only import the resources of the Cycloid project, it's the same as '--tags %s:%s,%s:PROJECT' and can be used with other --tags", cycloidTagKey, cycloidTagValue, cycloidProjectTagKey))
+
+	huaweicloudCmd.Flags().String("huaweicloud-resource-group-by-tag", "", fmt.Sprintf("Tag key used to group the resources on the HCL, one file per value of the tag when --hcl is a directory, the resources without it go to %q", huaweicloud.GroupByTagDefault))
+
+	huaweicloudCmd.Flags().String("huaweicloud-output-module", "", "Name of the Terraform module the resources are written in, the --hcl directory (or --module) has the 'module' block calling it and the resources on 'module-NAME' referencing each other, and the state addresses them as 'module.NAME'. It can not be used with --module-variables")
+
+	huaweicloudCmd.Flags().Int("huaweicloud-max-concurrency", 4, "Maximum number of resource types read at the same time, higher values are faster but may hit the API throttling")
+	huaweicloudCmd.Flags().Bool("huaweicloud-parallel-types-only", false, "Read the resource types referenced by other types (ex: huaweicloud_vpc) one by one before the rest, so only the types referencing them are read at the same time and the shared ones are listed once. The pages of a type are always read one after the other")
+	huaweicloudCmd.Flags().Bool("huaweicloud-rms", false, "Read the huaweicloud_vpc, huaweicloud_networking_secgroup, huaweicloud_as_group and huaweicloud_nat_gateway from the inventory of the Resource Management Service (RMS), which is faster than the service APIs, the rest of the types are always read from their service APIs. If the RMS is not enabled they are read from the service APIs")
+	huaweicloudCmd.Flags().String("huaweicloud-resourceql", "", "ResourceQL advanced query of the Resource Management Service (RMS) selecting the id, provider, type, region_id and ep_id of the resources to import (ex: \"SELECT id, provider, type, region_id, ep_id FROM resources WHERE provider = 'vpc'\"), instead of reading them from the service APIs. Only the types supported by --huaweicloud-rms of the region are imported")
+	huaweicloudCmd.Flags().Bool("huaweicloud-timings", false, "Log the duration of the reader of each resource type at the end of the listing (with -v), to know which ones slow down the import")
+	huaweicloudCmd.Flags().Bool("huaweicloud-verbose-reader-errors", false, "Log each resource that is read but not imported with the reason of it (ex: its status or the charging mode) at debug level (with -v), to know why fewer resources than expected were imported")
+
+	huaweicloudCmd.Flags().String("huaweicloud-created-after", "", "Only import the resources created after the RFC3339 timestamp (ex: 2024-06-01T00:00:00Z), for incremental imports. The resources without creation time are imported unless --huaweicloud-exclude-without-creation-time is used")
+	huaweicloudCmd.Flags().Bool("huaweicloud-exclude-without-creation-time", false, "Do not import the resources without creation time when --huaweicloud-created-after is used")
+
+	huaweicloudCmd.Flags().StringSliceVar(&huaweicloudStatuses, "huaweicloud-status", []string{}, "List of the only statuses of the resources to import (ex: ACTIVE), case-insensitive. The status is the one of the service API of each type, so the values differ per service (see the docs), and the types without status are imported")
+
+	huaweicloudCmd.Flags().String("huaweicloud-tag-transform", "", fmt.Sprintf("Rename the keys of the tags of the ECS instances on the HCL and the state, as a list of 'KEY:NEW_KEY' and '%s:PREFIX' to prefix the keys not renamed (ex: 'Env:env,CostCenter:cost_center,%s:hw_')", huaweicloudTagTransformAny, huaweicloudTagTransformAny))
+
+	huaweicloudCmd.Flags().IntVar(&maxPerType, "huaweicloud-max-per-type", 0, "Maximum number of resources to import of each type (ex: 10 to sample the account), 0 means no limit")
 
 	huaweicloudCmd.Flags().StringSliceVarP(&huaweicloudTags, "tags", "t", []string{}, "List of tags to filter with format 'NAME:VALUE'")
 }
+
+// huaweicloudOptions returns the huaweicloud.Options from the flags
+func huaweicloudOptions() (huaweicloud.Options, error) {
+	concurrency := viper.GetInt("max-concurrency")
+	if concurrency < 1 {
+		return huaweicloud.Options{}, fmt.Errorf("invalid --huaweicloud-max-concurrency %d, it has to be at least 1", concurrency)
+	}
+
+	var createdAfter time.Time
+	if s := viper.GetString("created-after"); s != "" {
+		var err error
+		createdAfter, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return huaweicloud.Options{}, fmt.Errorf("invalid --huaweicloud-created-after %q, it has to be an RFC3339 timestamp (ex: 2024-06-01T00:00:00Z)", s)
+		}
+	}
+
+	tagTransform, err := huaweicloudTagTransform(viper.GetString("tag-transform"))
+	if err != nil {
+		return huaweicloud.Options{}, err
+	}
+
+	catalog, err := huaweicloudCatalogFile(viper.GetString("catalog-file"))
+	if err != nil {
+		return huaweicloud.Options{}, err
+	}
+
+	return huaweicloud.Options{
+		TagsMissing:      viper.GetString("tags-missing"),
+		FlavorValidation: huaweicloud.FlavorValidation(viper.GetString("validate-flavors")),
+		Proxy:            viper.GetString("proxy"),
+		Insecure:         viper.GetBool("insecure"),
+		PluginCacheDir:   viper.GetString("plugin-cache-dir"),
+		Catalog:          catalog,
+
+		DRSIncludeFinished: viper.GetBool("drs-include-finished"),
+		IncludeDefaults:    viper.GetBool("include-defaults"),
+		InlineDataDisks:    viper.GetBool("inline-data-disks"),
+		InlineEIPs:         viper.GetBool("inline-eips"),
+		PinDHCPIPs:         viper.GetBool("pin-dhcp-ips"),
+		RDSReadReplicas:    viper.GetBool("rds-read-replicas"),
+
+		EnterpriseProjectID:   viper.GetString("enterprise-project-id"),
+		AllEnterpriseProjects: viper.GetBool("all-enterprise-projects"),
+
+		GroupByTag:   viper.GetString("resource-group-by-tag"),
+		ChargingMode: huaweicloud.ChargingMode(viper.GetString("charging-mode")),
+
+		BatchConcurrency:  concurrency,
+		ParallelTypesOnly: viper.GetBool("parallel-types-only"),
+		RMS:               viper.GetBool("rms"),
+		ResourceQL:        viper.GetString("resourceql"),
+		Timings:           viper.GetBool("timings"),
+
+		ValidateHCL:         viper.GetBool("validate-hcl"),
+		VerboseReaderErrors: viper.GetBool("verbose-reader-errors"),
+
+		CreatedAfter:               createdAfter,
+		ExcludeWithoutCreationTime: viper.GetBool("exclude-without-creation-time"),
+
+		Statuses: huaweicloudStatuses,
+
+		TagTransform: tagTransform,
+	}, nil
+}
+
+// The tags Cycloid sets on the resources it manages
+const (
+	cycloidTagKey        = "cycloid.io"
+	cycloidTagValue      = "true"
+	cycloidProjectTagKey = "project"
+)
+
+// huaweicloudTagTransformAny is the key of the --huaweicloud-tag-transform
+// which value is the prefix of the keys that are not renamed
+const huaweicloudTagTransformAny = "*"
+
+// huaweicloudTagTransform returns the huaweicloud.TagTransform of the
+// spec of the --huaweicloud-tag-transform, which is a comma separated
+// list of 'KEY:NEW_KEY' with the optional '*:PREFIX'
+func huaweicloudTagTransform(spec string) (huaweicloud.TagTransform, error) {
+	var tt huaweicloud.TagTransform
+	if spec == "" {
+		return tt, nil
+	}
+
+	for _, e := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(e), ":", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return tt, fmt.Errorf("invalid --huaweicloud-tag-transform %q, the expected format is 'KEY:NEW_KEY' or '%s:PREFIX'", e, huaweicloudTagTransformAny)
+		}
+
+		if kv[0] == huaweicloudTagTransformAny {
+			if tt.Prefix != "" {
+				return tt, fmt.Errorf("invalid --huaweicloud-tag-transform, the prefix is defined more than once")
+			}
+			tt.Prefix = kv[1]
+			continue
+		}
+
+		if tt.Renames == nil {
+			tt.Renames = make(map[string]string)
+		}
+		if _, ok := tt.Renames[kv[0]]; ok {
+			return tt, fmt.Errorf("invalid --huaweicloud-tag-transform, the key %q is renamed more than once", kv[0])
+		}
+		tt.Renames[kv[0]] = kv[1]
+	}
+
+	return tt, nil
+}
+
+// huaweicloudCatalogFile reads the JSON --huaweicloud-catalog-file on path,
+// it returns nil if there is no path. The catalog is validated by the
+// huaweicloud.NewProvider
+func huaweicloudCatalogFile(path string) (*huaweicloud.Catalog, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not ReadFile on path %q: %w", path, err)
+	}
+
+	var c huaweicloud.Catalog
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("invalid JSON on catalog file %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// huaweicloudCatalogRegion returns the region and project ID to import
+// from the regions discovered with --huaweicloud-region-from-catalog. The
+// region is the only one discovered or, if defined, the one of them with
+// that name. The projectID, if defined, is kept. Only one region is
+// imported per run, as each one has its own provider configuration and
+// state, so the error of many regions lists all of them to import them
+// one by one
+func huaweicloudCatalogRegion(regions []huaweicloud.Region, region, projectID string) (string, string, error) {
+	if len(regions) == 0 {
+		return "", "", fmt.Errorf("the credentials can not access any region, none was discovered on their IAM projects")
+	}
+
+	names := make([]string, 0, len(regions))
+	for _, r := range regions {
+		names = append(names, r.Name)
+	}
+
+	var found *huaweicloud.Region
+	if region != "" {
+		for i, r := range regions {
+			if r.Name == region {
+				found = &regions[i]
+				break
+			}
+		}
+		if found == nil {
+			return "", "", fmt.Errorf("invalid --huaweicloud-region %q, the credentials can only access the regions %s", region, strings.Join(names, ", "))
+		}
+	} else if len(regions) == 1 {
+		found = &regions[0]
+	} else {
+		return "", "", fmt.Errorf("the credentials can access %d regions and only one is imported per run, import each one of them with '--huaweicloud-region-from-catalog --huaweicloud-region REGION' being REGION one of %s", len(regions), strings.Join(names, ", "))
+	}
+
+	if projectID == "" {
+		projectID = found.ProjectID
+	}
+
+	return found.Name, projectID, nil
+}
+
+// huaweicloudModuleNameRe are the valid names of the
+// --huaweicloud-output-module, which are TF identifiers
+var huaweicloudModuleNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// huaweicloudOutputModule validates the name of the --huaweicloud-output-module
+// and sets it as the outputModule and, if the output is the --hcl directory,
+// writes it as the --module one
+func huaweicloudOutputModule(name string) error {
+	outputModule = ""
+	if name == "" {
+		return nil
+	}
+
+	if !huaweicloudModuleNameRe.MatchString(name) {
+		return fmt.Errorf("invalid --huaweicloud-output-module %q, it has to start with a letter or underscore and only have letters, digits, underscores and dashes", name)
+	}
+
+	if viper.GetString("module-variables") != "" {
+		return fmt.Errorf("the flags --huaweicloud-output-module and --module-variables are mutually exclusive")
+	}
+
+	if hcl := viper.GetString("hcl"); viper.GetString("module") == "" && hcl != "" {
+		if !isHCLDir {
+			return fmt.Errorf("the --huaweicloud-output-module requires the --hcl to be a directory, %q is a file", hcl)
+		}
+		viper.Set("module", hcl)
+	}
+
+	outputModule = name
+
+	return nil
+}
+
+// huaweicloudCycloidTags returns the tags with the ones Cycloid sets on the
+// resources of the project, it fails if the tags already have one of the
+// Cycloid keys with a different value as no resource would match
+func huaweicloudCycloidTags(tags []tag.Tag, project string) ([]tag.Tag, error) {
+	cts := []tag.Tag{
+		{Name: cycloidTagKey, Value: cycloidTagValue},
+		{Name: cycloidProjectTagKey, Value: project},
+	}
+
+	res := append([]tag.Tag{}, tags...)
+	for _, ct := range cts {
+		found := false
+		for _, t := range tags {
+			if t.Name != ct.Name {
+				continue
+			}
+			if t.Value != ct.Value {
+				return nil, fmt.Errorf("the flag --huaweicloud-cycloid-project conflicts with the tag %s:%s of --tags", t.Name, t.Value)
+			}
+			found = true
+		}
+		if !found {
+			res = append(res, ct)
+		}
+	}
+
+	return res, nil
+}
+
+// huaweicloudOnlyInclude validates the resource types of the
+// --huaweicloud-only and returns them to be used as the include
+// list, it fails if the exclude list is also set
+func huaweicloudOnlyInclude(only, exclude []string) ([]string, error) {
+	if len(exclude) != 0 {
+		return nil, fmt.Errorf("the flags --huaweicloud-only and --exclude are mutually exclusive")
+	}
+
+	return huaweicloudResourceTypes("huaweicloud-only", only)
+}
+
+// huaweicloudResourceTypes resolves the resource types of the flag,
+// which can also be short names (ex: 'vpc') or aliases (ex: 'ecs'),
+// and returns the resource types of them
+func huaweicloudResourceTypes(flag string, types []string) ([]string, error) {
+	res := make([]string, 0, len(types))
+	for _, t := range types {
+		rt, err := huaweicloud.ResolveResourceType(t)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flag, err)
+		}
+		res = append(res, string(rt))
+	}
+
+	return res, nil
+}
+
+// huaweicloudTargets resolves the resource type of each one of the
+// --target ('TYPE.ID'), the ones with an invalid format are
+// returned as they are to fail on the filter validation
+func huaweicloudTargets(targets []string) ([]string, error) {
+	res := make([]string, 0, len(targets))
+	for _, t := range targets {
+		st := strings.SplitN(t, ".", 2)
+		if len(st) != 2 {
+			res = append(res, t)
+			continue
+		}
+
+		rt, err := huaweicloud.ResolveResourceType(st[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid --target %q: %w", t, err)
+		}
+		res = append(res, string(rt)+"."+st[1])
+	}
+
+	return res, nil
+}
+
+// huaweicloudResourcesConfig is the content of the --huaweicloud-resources-file
+type huaweicloudResourcesConfig struct {
+	Resources []struct {
+		Type string   `yaml:"type" json:"type"`
+		Tags []string `yaml:"tags" json:"tags"`
+		Name string   `yaml:"name" json:"name"`
+		Max  int      `yaml:"max" json:"max"`
+	} `yaml:"resources" json:"resources"`
+}
+
+// huaweicloudResourcesFile reads the --huaweicloud-resources-file on path
+// and returns the resource types to be used as the include list with the
+// filters of each one of them, it fails if the exclude list is also set
+func huaweicloudResourcesFile(path string, exclude []string) ([]string, map[string]huaweicloud.TypeFilter, error) {
+	if len(exclude) != 0 {
+		return nil, nil, fmt.Errorf("the flags --huaweicloud-resources-file and --exclude are mutually exclusive")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not ReadFile on path %q: %w", path, err)
+	}
+
+	var cfg huaweicloudResourcesConfig
+	switch filepath.Ext(path) {
+	case ".yml", ".yaml":
+		err = yaml.UnmarshalStrict(b, &cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid YAML on resources file %s: %w", path, err)
+		}
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid JSON on resources file %s: %w", path, err)
+		}
+	default:
+		return nil, nil, fmt.Errorf("invalid resources file %s, only supported extensions are yaml/yml/json", path)
+	}
+
+	if len(cfg.Resources) == 0 {
+		return nil, nil, fmt.Errorf("invalid resources file %s, it has no resources", path)
+	}
+
+	include := make([]string, 0, len(cfg.Resources))
+	filters := make(map[string]huaweicloud.TypeFilter)
+	for _, r := range cfg.Resources {
+		rt, err := huaweicloud.ResolveResourceType(r.Type)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid resources file %s: %w", path, err)
+		}
+		if _, ok := filters[string(rt)]; ok {
+			return nil, nil, fmt.Errorf("invalid resources file %s, the type %q is duplicated", path, rt)
+		}
+		r.Type = string(rt)
+
+		tf := huaweicloud.TypeFilter{Max: r.Max}
+		for _, t := range r.Tags {
+			tg, err := tag.New(t)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid resources file %s, tag %q of %q: %w", path, t, r.Type, err)
+			}
+			tf.Tags = append(tf.Tags, tg)
+		}
+		if r.Name != "" {
+			tf.Name, err = regexp.Compile(r.Name)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid resources file %s, name of %q: %w", path, r.Type, err)
+			}
+		}
+
+		include = append(include, r.Type)
+		filters[r.Type] = tf
+	}
+
+	return include, filters, nil
+}
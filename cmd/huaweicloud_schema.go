@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cycloidio/terracognita/huaweicloud"
+	"github.com/spf13/cobra"
+)
+
+var (
+	huaweicloudSchemaCmd = &cobra.Command{
+		Use:    "schema TYPE",
+		Short:  "Prints the TF Provider schema of a Huawei Cloud supported Resource",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rt, err := huaweicloud.ResolveResourceType(args[0])
+			if err != nil {
+				return err
+			}
+
+			b, err := huaweicloud.ResourceSchema(string(rt))
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(b))
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,474 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/huaweicloud"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestHuaweicloudOnlyInclude(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		include, err := huaweicloudOnlyInclude([]string{"huaweicloud_compute_instance", "huaweicloud_vpc"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_compute_instance", "huaweicloud_vpc"}, include)
+	})
+
+	t.Run("SuccessAliases", func(t *testing.T) {
+		include, err := huaweicloudOnlyInclude([]string{"ecs", "vpc"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_compute_instance", "huaweicloud_vpc"}, include)
+	})
+
+	t.Run("ErrorWithExclude", func(t *testing.T) {
+		_, err := huaweicloudOnlyInclude([]string{"huaweicloud_compute_instance"}, []string{"huaweicloud_vpc"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mutually exclusive")
+	})
+
+	t.Run("ErrorInvalidType", func(t *testing.T) {
+		_, err := huaweicloudOnlyInclude([]string{"huaweicloud_unknown"}, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported resource type "huaweicloud_unknown"`)
+	})
+}
+
+func TestHuaweicloudResourceTypes(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		types, err := huaweicloudResourceTypes("exclude", []string{"huaweicloud_vpc", "subnet", "s3_bucket"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_vpc", "huaweicloud_vpc_subnet", "huaweicloud_obs_bucket"}, types)
+	})
+
+	t.Run("ErrorUnknownAlias", func(t *testing.T) {
+		_, err := huaweicloudResourceTypes("exclude", []string{"vpc", "unknown"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid --exclude: unsupported resource type "unknown"`)
+	})
+}
+
+func TestHuaweicloudTargets(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		targets, err := huaweicloudTargets([]string{"huaweicloud_vpc.vpc-1", "ecs.ecs-1", "obs.my.bucket", "invalid"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_vpc.vpc-1", "huaweicloud_compute_instance.ecs-1", "huaweicloud_obs_bucket.my.bucket", "invalid"}, targets)
+	})
+
+	t.Run("ErrorUnknownAlias", func(t *testing.T) {
+		_, err := huaweicloudTargets([]string{"unknown.id-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported resource type "unknown"`)
+	})
+}
+
+func TestHuaweicloudOptions(t *testing.T) {
+	viper.BindPFlag("huaweicloud-max-concurrency", huaweicloudCmd.Flags().Lookup("huaweicloud-max-concurrency"))
+	viper.RegisterAlias("max-concurrency", "huaweicloud-max-concurrency")
+	viper.BindPFlag("huaweicloud-parallel-types-only", huaweicloudCmd.Flags().Lookup("huaweicloud-parallel-types-only"))
+	viper.RegisterAlias("parallel-types-only", "huaweicloud-parallel-types-only")
+	viper.BindPFlag("huaweicloud-resourceql", huaweicloudCmd.Flags().Lookup("huaweicloud-resourceql"))
+	viper.RegisterAlias("resourceql", "huaweicloud-resourceql")
+	defer viper.Reset()
+
+	t.Run("DefaultMaxConcurrency", func(t *testing.T) {
+		opts, err := huaweicloudOptions()
+		require.NoError(t, err)
+		assert.Equal(t, 4, opts.BatchConcurrency)
+		assert.False(t, opts.ParallelTypesOnly)
+		assert.Empty(t, opts.ResourceQL)
+	})
+
+	t.Run("MaxConcurrency", func(t *testing.T) {
+		viper.Set("max-concurrency", 8)
+
+		opts, err := huaweicloudOptions()
+		require.NoError(t, err)
+		assert.Equal(t, 8, opts.BatchConcurrency)
+	})
+
+	t.Run("ParallelTypesOnly", func(t *testing.T) {
+		viper.Set("parallel-types-only", true)
+
+		opts, err := huaweicloudOptions()
+		require.NoError(t, err)
+		assert.True(t, opts.ParallelTypesOnly)
+	})
+
+	t.Run("ResourceQL", func(t *testing.T) {
+		viper.Set("resourceql", "SELECT id, provider, type FROM resources")
+
+		opts, err := huaweicloudOptions()
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT id, provider, type FROM resources", opts.ResourceQL)
+	})
+
+	t.Run("ErrorMaxConcurrency", func(t *testing.T) {
+		viper.Set("max-concurrency", 0)
+
+		_, err := huaweicloudOptions()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--huaweicloud-max-concurrency")
+	})
+}
+
+func TestHuaweicloudCatalogFile(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "catalog.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(`{"regions": {"hcs-1": {"cloud": "hcs.example.com", "endpoints": {"ecs": "https://ecs.hcs.example.com"}}}}`), 0644))
+
+		c, err := huaweicloudCatalogFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, &huaweicloud.Catalog{
+			Regions: map[string]huaweicloud.RegionCatalog{
+				"hcs-1": {
+					Cloud:     "hcs.example.com",
+					Endpoints: map[string]string{"ecs": "https://ecs.hcs.example.com"},
+				},
+			},
+		}, c)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		c, err := huaweicloudCatalogFile("")
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+	t.Run("ErrorUnknownField", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "catalog.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(`{"regions": {"hcs-1": {"domain": "hcs.example.com"}}}`), 0644))
+
+		_, err := huaweicloudCatalogFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JSON on catalog file")
+	})
+}
+
+func TestHuaweicloudCatalogRegion(t *testing.T) {
+	regions := []huaweicloud.Region{
+		{Name: "ap-southeast-1", ProjectID: "p-ap-1"},
+		{Name: "cn-north-4", ProjectID: "p-north-4"},
+	}
+
+	tcs := []struct {
+		Name      string
+		Regions   []huaweicloud.Region
+		Region    string
+		ProjectID string
+
+		ERegion    string
+		EProjectID string
+		Err        string
+	}{
+		{
+			Name:       "OnlyOne",
+			Regions:    regions[1:],
+			ERegion:    "cn-north-4",
+			EProjectID: "p-north-4",
+		},
+		{
+			Name:       "WithRegion",
+			Regions:    regions,
+			Region:     "ap-southeast-1",
+			ERegion:    "ap-southeast-1",
+			EProjectID: "p-ap-1",
+		},
+		{
+			Name:       "WithProjectID",
+			Regions:    regions,
+			Region:     "cn-north-4",
+			ProjectID:  "p-custom",
+			ERegion:    "cn-north-4",
+			EProjectID: "p-custom",
+		},
+		{
+			Name: "ErrorNoRegions",
+			Err:  "can not access any region",
+		},
+		{
+			Name:    "ErrorUnknownRegion",
+			Regions: regions,
+			Region:  "eu-west-0",
+			Err:     "can only access the regions ap-southeast-1, cn-north-4",
+		},
+		{
+			Name:    "ErrorManyRegions",
+			Regions: regions,
+			Err:     "can access 2 regions and only one is imported per run",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			region, projectID, err := huaweicloudCatalogRegion(tc.Regions, tc.Region, tc.ProjectID)
+			if tc.Err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.Err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.ERegion, region)
+			assert.Equal(t, tc.EProjectID, projectID)
+		})
+	}
+}
+
+func TestHuaweicloudOutputModule(t *testing.T) {
+	defer viper.Reset()
+	defer func() { isHCLDir = false }()
+	defer func() { outputModule = "" }()
+
+	t.Run("Empty", func(t *testing.T) {
+		viper.Set("hcl", "out.tf")
+
+		outputModule = "network"
+
+		require.NoError(t, huaweicloudOutputModule(""))
+		assert.Empty(t, viper.GetString("module"))
+		assert.Empty(t, outputModule)
+	})
+
+	t.Run("HCLDir", func(t *testing.T) {
+		viper.Reset()
+		viper.Set("hcl", "out")
+		isHCLDir = true
+
+		require.NoError(t, huaweicloudOutputModule("network"))
+		assert.Equal(t, "out", viper.GetString("module"))
+		assert.Equal(t, "network", moduleName())
+
+		opts, err := getWriterOptions()
+		require.NoError(t, err)
+		assert.Equal(t, "network", opts.Module)
+		assert.True(t, opts.ModuleWithoutVariables)
+	})
+
+	t.Run("TFStateOnly", func(t *testing.T) {
+		viper.Reset()
+		viper.Set("tfstate", "terraform.tfstate")
+
+		require.NoError(t, huaweicloudOutputModule("network"))
+		assert.Empty(t, viper.GetString("module"))
+
+		opts, err := getWriterOptions()
+		require.NoError(t, err)
+		assert.Equal(t, "network", opts.Module)
+	})
+
+	t.Run("ErrorHCLFile", func(t *testing.T) {
+		viper.Reset()
+		viper.Set("hcl", "out.tf")
+		isHCLDir = false
+
+		err := huaweicloudOutputModule("network")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--hcl")
+	})
+
+	t.Run("ErrorModuleVariables", func(t *testing.T) {
+		viper.Reset()
+		viper.Set("module", "out")
+		viper.Set("module-variables", "variables.yml")
+
+		err := huaweicloudOutputModule("network")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--module-variables")
+	})
+
+	t.Run("ErrorName", func(t *testing.T) {
+		viper.Reset()
+
+		err := huaweicloudOutputModule("1network")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --huaweicloud-output-module")
+	})
+}
+
+func TestHuaweicloudTagTransform(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tt, err := huaweicloudTagTransform("Env:env, CostCenter:cost_center,*:hw_")
+		require.NoError(t, err)
+		assert.Equal(t, huaweicloud.TagTransform{
+			Renames: map[string]string{"Env": "env", "CostCenter": "cost_center"},
+			Prefix:  "hw_",
+		}, tt)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		tt, err := huaweicloudTagTransform("")
+		require.NoError(t, err)
+		assert.Equal(t, huaweicloud.TagTransform{}, tt)
+	})
+
+	t.Run("ErrorFormat", func(t *testing.T) {
+		_, err := huaweicloudTagTransform("Env:env,team")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid --huaweicloud-tag-transform "team"`)
+	})
+
+	t.Run("ErrorDuplicatedKey", func(t *testing.T) {
+		_, err := huaweicloudTagTransform("Env:env,Env:environment")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `the key "Env" is renamed more than once`)
+	})
+}
+
+func TestHuaweicloudResourcesFile(t *testing.T) {
+	write := func(t *testing.T, name, content string) string {
+		p := filepath.Join(t.TempDir(), name)
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+		return p
+	}
+
+	t.Run("SuccessYAML", func(t *testing.T) {
+		p := write(t, "resources.yml", `
+resources:
+  - type: huaweicloud_compute_instance
+    tags:
+      - env:prod
+      - team:web
+    name: ^web-
+    max: 10
+  - type: huaweicloud_vpc
+`)
+
+		include, filters, err := huaweicloudResourcesFile(p, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_compute_instance", "huaweicloud_vpc"}, include)
+
+		require.Len(t, filters, 2)
+		instance := filters["huaweicloud_compute_instance"]
+		assert.Equal(t, []tag.Tag{{Name: "env", Value: "prod"}, {Name: "team", Value: "web"}}, instance.Tags)
+		require.NotNil(t, instance.Name)
+		assert.Equal(t, "^web-", instance.Name.String())
+		assert.Equal(t, 10, instance.Max)
+
+		assert.Equal(t, huaweicloud.TypeFilter{}, filters["huaweicloud_vpc"])
+	})
+
+	t.Run("SuccessJSON", func(t *testing.T) {
+		p := write(t, "resources.json", `{"resources": [{"type": "huaweicloud_obs_bucket", "max": 5}]}`)
+
+		include, filters, err := huaweicloudResourcesFile(p, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_obs_bucket"}, include)
+		assert.Equal(t, map[string]huaweicloud.TypeFilter{"huaweicloud_obs_bucket": {Max: 5}}, filters)
+	})
+
+	t.Run("SuccessAliases", func(t *testing.T) {
+		p := write(t, "resources.yml", "resources: [{type: ecs, max: 2}, {type: vpc}]")
+
+		include, filters, err := huaweicloudResourcesFile(p, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"huaweicloud_compute_instance", "huaweicloud_vpc"}, include)
+		assert.Equal(t, map[string]huaweicloud.TypeFilter{
+			"huaweicloud_compute_instance": {Max: 2},
+			"huaweicloud_vpc":              {},
+		}, filters)
+	})
+
+	t.Run("ErrorDuplicatedAlias", func(t *testing.T) {
+		p := write(t, "resources.yml", "resources: [{type: huaweicloud_vpc}, {type: vpc}]")
+
+		_, _, err := huaweicloudResourcesFile(p, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `the type "huaweicloud_vpc" is duplicated`)
+	})
+
+	t.Run("ErrorWithExclude", func(t *testing.T) {
+		p := write(t, "resources.yml", "resources: [{type: huaweicloud_vpc}]")
+
+		_, _, err := huaweicloudResourcesFile(p, []string{"huaweicloud_vpc"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mutually exclusive")
+	})
+
+	t.Run("ErrorInvalidType", func(t *testing.T) {
+		p := write(t, "resources.yml", "resources: [{type: huaweicloud_unknown}]")
+
+		_, _, err := huaweicloudResourcesFile(p, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported resource type "huaweicloud_unknown"`)
+	})
+
+	t.Run("ErrorUnknownKey", func(t *testing.T) {
+		p := write(t, "resources.json", `{"resources": [{"type": "huaweicloud_vpc", "limit": 5}]}`)
+
+		_, _, err := huaweicloudResourcesFile(p, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JSON")
+	})
+
+	t.Run("ErrorInvalidTag", func(t *testing.T) {
+		p := write(t, "resources.yml", "resources: [{type: huaweicloud_vpc, tags: [env]}]")
+
+		_, _, err := huaweicloudResourcesFile(p, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NAME:VALUE")
+	})
+
+	t.Run("ErrorExtension", func(t *testing.T) {
+		p := write(t, "resources.txt", "")
+
+		_, _, err := huaweicloudResourcesFile(p, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported extensions")
+	})
+}
+
+func TestHuaweicloudCycloidTags(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tags, err := huaweicloudCycloidTags(nil, "webapp")
+		require.NoError(t, err)
+		assert.Equal(t, []tag.Tag{
+			{Name: "cycloid.io", Value: "true"},
+			{Name: "project", Value: "webapp"},
+		}, tags)
+	})
+
+	t.Run("SuccessWithTags", func(t *testing.T) {
+		tags, err := huaweicloudCycloidTags([]tag.Tag{{Name: "env", Value: "prod"}, {Name: "project", Value: "webapp"}}, "webapp")
+		require.NoError(t, err)
+		assert.Equal(t, []tag.Tag{
+			{Name: "env", Value: "prod"},
+			{Name: "project", Value: "webapp"},
+			{Name: "cycloid.io", Value: "true"},
+		}, tags)
+	})
+
+	t.Run("ErrorConflictingTag", func(t *testing.T) {
+		_, err := huaweicloudCycloidTags([]tag.Tag{{Name: "project", Value: "other"}}, "webapp")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicts")
+	})
+}
+
+func TestHuaweicloudSchema(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var out bytes.Buffer
+		huaweicloudSchemaCmd.SetOut(&out)
+		defer huaweicloudSchemaCmd.SetOut(nil)
+
+		err := huaweicloudSchemaCmd.RunE(huaweicloudSchemaCmd, []string{"huaweicloud_vpc"})
+		require.NoError(t, err)
+
+		var schema struct {
+			Attributes map[string]interface{}
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+		assert.NotEmpty(t, schema.Attributes)
+		assert.Contains(t, schema.Attributes, "cidr")
+	})
+
+	t.Run("ErrorInvalidType", func(t *testing.T) {
+		err := huaweicloudSchemaCmd.RunE(huaweicloudSchemaCmd, []string{"huaweicloud_unknown"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported resource type "huaweicloud_unknown"`)
+	})
+}
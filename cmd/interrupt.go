@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// interruptSignals are the signals that interrupt the import
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// interruptExitCode is the exit status after the second
+// interruptSignals, the one of the shells for a SIGINT
+const interruptExitCode = 130
+
+// interruptExit exits the process after the second
+// interruptSignals, it's replaced on the tests
+var interruptExit = os.Exit
+
+// interruptOut is where the notices of the interruptSignals are
+// written, it's not the logsOut so they are printed even with
+// --quiet, as the import stops and the output is partial
+var interruptOut io.Writer = os.Stderr
+
+// interruptOnSignal returns a copy of ctx that is interrupted (see
+// provider.WithInterrupt) with the first interruptSignals, so the
+// resources read until then are written, and the second one exits
+// right away with the interruptExitCode, as the reads in progress
+// can not be canceled. The stop has to be called once the import
+// is done to stop catching the signals
+func interruptOnSignal(ctx context.Context, out io.Writer) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, interruptSignals...)
+
+	interrupt := make(chan struct{})
+	go func() {
+		select {
+		case <-sigs:
+		case <-ctx.Done():
+			return
+		}
+
+		fmt.Fprintf(out, "\nInterrupted, writing the resources read so far (interrupt again to exit now)\n")
+		close(interrupt)
+
+		select {
+		case <-sigs:
+			signal.Stop(sigs)
+			cancel()
+			fmt.Fprintf(out, "Interrupted again, exiting\n")
+			interruptExit(interruptExitCode)
+		case <-ctx.Done():
+		}
+	}()
+
+	stop := func() {
+		signal.Stop(sigs)
+		cancel()
+	}
+
+	return provider.WithInterrupt(ctx, interrupt), stop
+}
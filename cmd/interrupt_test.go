@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestInterruptOnSignal(t *testing.T) {
+	exits := make(chan int, 1)
+	interruptExit = func(code int) { exits <- code }
+	defer func() { interruptExit = os.Exit }()
+
+	var out bytes.Buffer
+	ctx, stop := interruptOnSignal(context.Background(), &out)
+	defer stop()
+
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGINT))
+	require.Eventually(t, func() bool { return provider.IsInterrupted(ctx) }, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, ctx.Err())
+	assert.Contains(t, out.String(), "Interrupted, writing the resources read so far")
+
+	// The second signal exits right away
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGINT))
+	select {
+	case code := <-exits:
+		assert.Equal(t, interruptExitCode, code)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the second signal did not exit")
+	}
+	assert.Error(t, ctx.Err())
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/adrg/xdg"
 	"github.com/cycloidio/mxwriter"
+	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/hcl"
 	"github.com/cycloidio/terracognita/log"
@@ -39,6 +40,14 @@ var (
 	include, exclude, targets []string
 	logsOut                   io.Writer
 
+	// maxPerType is set by the providers that support
+	// a maximum number of resources to import of each type
+	maxPerType int
+
+	// outputModule is set by the providers that support writing
+	// the resources inside of a module, it's the name of the module
+	outputModule string
+
 	// RootCmd it's the entry command for the cmd on terracognita
 	RootCmd = &cobra.Command{
 		Use:   "terracognita",
@@ -67,6 +76,12 @@ var (
 				log.Init(logFile, false)
 			}
 
+			// The progress and the summary of
+			// the import are not written
+			if viper.GetBool("quiet") {
+				logsOut = ioutil.Discard
+			}
+
 			return nil
 		},
 	}
@@ -196,8 +211,7 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		moduleName := filepath.Base(m)
-		mdir := fmt.Sprintf("module-%s", moduleName)
+		mdir := fmt.Sprintf("module-%s", moduleName())
 
 		err = os.Mkdir(filepath.Join(m, mdir), 0700)
 		if err != nil {
@@ -287,20 +301,38 @@ func getWriterOptions() (*writer.Options, error) {
 		}
 	}
 
+	// The state of the resources is also addressed on
+	// the output module when there is no HCL module
+	if outputModule != "" {
+		module = outputModule
+	}
+
 	return &writer.Options{
-		Interpolate:      viper.GetBool("interpolate"),
-		Module:           module,
-		ModuleVariables:  mv,
-		HCLProviderBlock: viper.GetBool("hcl-provider-block"),
+		Interpolate:            viper.GetBool("interpolate"),
+		Module:                 module,
+		ModuleVariables:        mv,
+		ModuleWithoutVariables: outputModule != "",
+		HCLProviderBlock:       viper.GetBool("hcl-provider-block"),
 	}, nil
 }
 
+// moduleName returns the name of the module written on the --module
+// directory, which is the outputModule if defined or the name of the directory
+func moduleName() string {
+	if outputModule != "" {
+		return outputModule
+	}
+	return filepath.Base(viper.GetString("module"))
+}
+
 func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provider, tags []tag.Tag) error {
 	f := &filter.Filter{
 		Include: include,
 		Exclude: exclude,
 		Targets: targets,
 		Tags:    tags,
+
+		MaxPerType: maxPerType,
 	}
 
 	var hclW, stateW writer.Writer
@@ -323,8 +355,18 @@ func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provid
 
 	fmt.Fprintf(logsOut, "Starting Terracognita with version %s\n", Version)
 	logger.Log("msg", "starting terracognita", "version", Version)
+
+	ctx, stop := interruptOnSignal(ctx, interruptOut)
+	defer stop()
+
 	err = provider.Import(ctx, p, hclW, stateW, f, logsOut)
 	if err != nil {
+		// The interrupted import already wrote the resources read
+		// so it's not an error, the PostRunE flushes them
+		if errors.Is(err, errcode.ErrImportInterrupted) {
+			fmt.Fprintf(interruptOut, "%s\n", errcode.ErrImportInterrupted)
+			return nil
+		}
 		return errors.Wrap(err, "could not import from "+p.String())
 	}
 
@@ -383,6 +425,9 @@ func init() {
 	RootCmd.PersistentFlags().BoolP("debug", "d", false, "Activate the debug mode which includes TF logs via TF_LOG=TRACE|DEBUG|INFO|WARN|ERROR configuration https://www.terraform.io/docs/internals/debugging.html")
 	_ = viper.BindPFlag("debug", RootCmd.PersistentFlags().Lookup("debug"))
 
+	RootCmd.PersistentFlags().BoolP("quiet", "q", false, "Do not print the progress of the import nor the summary table of the imported resources at the end of it")
+	_ = viper.BindPFlag("quiet", RootCmd.PersistentFlags().Lookup("quiet"))
+
 	RootCmd.PersistentFlags().String("log-file", path.Join(xdg.CacheHome, "terracognita", "terracognita.log"), "Write the logs with -v to this destination")
 	_ = viper.BindPFlag("log-file", RootCmd.PersistentFlags().Lookup("log-file"))
 
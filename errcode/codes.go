@@ -4,10 +4,14 @@ import "errors"
 
 // List of all the error Codes used
 var (
-	ErrProviderResourceNotSupported  = errors.New("the resource type is not supported")
-	ErrProviderResourceNotRead       = errors.New("the resource did not return an ID")
-	ErrProviderResourceDoNotMatchTag = errors.New("the resource does not match the required tags")
-	ErrProviderResourceAutogenerated = errors.New("the resource is autogenerated and should not be imported")
+	ErrProviderResourceNotSupported     = errors.New("the resource type is not supported")
+	ErrProviderResourceNotRead          = errors.New("the resource did not return an ID")
+	ErrProviderResourceDoNotMatchTag    = errors.New("the resource does not match the required tags")
+	ErrProviderResourceDoNotMatchName   = errors.New("the resource does not match the required name")
+	ErrProviderResourceAutogenerated    = errors.New("the resource is autogenerated and should not be imported")
+	ErrProviderResourceInvalidConfig    = errors.New("the resource configuration does not satisfy the schema")
+	ErrProviderResourceCreatedBefore    = errors.New("the resource was not created after the required time")
+	ErrProviderResourceDoNotMatchStatus = errors.New("the resource does not match the required statuses")
 
 	ErrCacheKeyNotFound        = errors.New("the key used to search was not found")
 	ErrCacheKeyAlreadyExisting = errors.New("the key already exists on the cache")
@@ -18,10 +22,13 @@ var (
 	ErrWriterInvalidTypeValue = errors.New("invalid type of value")
 	ErrWriterAlreadyExistsKey = errors.New("the key already exists")
 
-	ErrFilterTargetsInvalid = errors.New("the filter targets has an invalid format")
+	ErrFilterTargetsInvalid    = errors.New("the filter targets has an invalid format")
+	ErrFilterMaxPerTypeInvalid = errors.New("the filter max per type can not be negative")
 
 	ErrTagInvalidForamt = errors.New("invalid format for tag, the expected format is 'NAME:VALUE'")
 
+	ErrImportInterrupted = errors.New("the import was interrupted, only the resources read until then were written")
+
 	// ErrProviderAPI will be raised when an error occurs provider side while
 	// using its APIs (authorization error, unavailable operation, ...)
 	ErrProviderAPI = errors.New("error while requesting the provider APIs")
@@ -18,6 +18,11 @@ type Filter struct {
 	Exclude []string
 	Targets []string
 
+	// MaxPerType is the maximum number of resources
+	// to read of each type, 0 means no limit.
+	// It's honored by the providers that support it
+	MaxPerType int
+
 	exclude map[string]struct{}
 	include map[string]struct{}
 }
@@ -72,9 +77,19 @@ func (f *Filter) Validate() error {
 		}
 	}
 
+	if f.MaxPerType < 0 {
+		return errors.Wrapf(errcode.ErrFilterMaxPerTypeInvalid, "the MaxPerType %d is invalid", f.MaxPerType)
+	}
+
 	return nil
 }
 
+// IsMaxPerTypeReached checks if the n resources
+// read of a type reached the MaxPerType
+func (f *Filter) IsMaxPerTypeReached(n int) bool {
+	return f.MaxPerType > 0 && n >= f.MaxPerType
+}
+
 // TargetsTypesWithIDs returns all the types (ex: aws_instance) from
 // the list of Targets and the IDs
 func (f *Filter) TargetsTypesWithIDs() map[string][]string {
@@ -108,7 +123,8 @@ func (f *Filter) String() string {
 	Include: %s,
 	Exclude: %s,
 	Targets: %s,
-`, f.Tags, f.Include, f.Exclude, f.Targets)
+	MaxPerType: %d,
+`, f.Tags, f.Include, f.Exclude, f.Targets, f.MaxPerType)
 }
 
 // calculateExcludeMap makes a map of the Exclude so
@@ -74,4 +74,24 @@ func TestValidate(t *testing.T) {
 		err := f.Validate()
 		assert.Error(t, errors.Cause(err), errcode.ErrFilterTargetsInvalid)
 	})
+	t.Run("ErrorNegativeMaxPerType", func(t *testing.T) {
+		f := filter.Filter{MaxPerType: -1}
+		err := f.Validate()
+		assert.Equal(t, errcode.ErrFilterMaxPerTypeInvalid, errors.Cause(err))
+	})
+}
+
+func TestIsMaxPerTypeReached(t *testing.T) {
+	t.Run("NoLimit", func(t *testing.T) {
+		f := filter.Filter{}
+		assert.False(t, f.IsMaxPerTypeReached(100))
+	})
+	t.Run("True", func(t *testing.T) {
+		f := filter.Filter{MaxPerType: 2}
+		assert.True(t, f.IsMaxPerTypeReached(2))
+	})
+	t.Run("False", func(t *testing.T) {
+		f := filter.Filter{MaxPerType: 2}
+		assert.False(t, f.IsMaxPerTypeReached(1))
+	})
 }
@@ -7,6 +7,7 @@ require (
 	github.com/Azure/go-autorest/autorest v0.11.27
 	github.com/adrg/xdg v0.2.3
 	github.com/aws/aws-sdk-go v1.43.34
+	github.com/chnsz/golangsdk v0.0.0-20250829092604-a21a0532b48a
 	github.com/chr4/pwgen v1.1.0
 	github.com/cycloidio/mxwriter v1.0.4
 	github.com/cycloidio/tfdocs v0.0.0-20230516095646-1dc8f8412d50
@@ -86,7 +87,6 @@ require (
 	github.com/btubbs/datetime v0.1.0 // indirect
 	github.com/cenkalti/backoff v2.2.1+incompatible // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
-	github.com/chnsz/golangsdk v0.0.0-20250829092604-a21a0532b48a // indirect
 	github.com/cloudflare/circl v1.6.1 // indirect
 	github.com/cncf/xds/go v0.0.0-20250501225837-2ac532fd4443 // indirect
 	github.com/coreos/go-systemd v0.0.0-20190620071333-e64a0ec8b42a // indirect
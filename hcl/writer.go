@@ -191,8 +191,11 @@ func (w *Writer) Sync() error {
 
 	categories := w.categories
 	if w.opts.HasModule() {
-		categories = append(categories, []string{writer.ModuleCategoryKey, variablesCategoryKey}...)
-		w.setVariables()
+		categories = append(categories, writer.ModuleCategoryKey)
+		if !w.opts.ModuleWithoutVariables {
+			categories = append(categories, variablesCategoryKey)
+			w.setVariables()
+		}
 	}
 
 	for _, category := range categories {
@@ -538,7 +541,7 @@ func (w *Writer) Interpolate(i *interpolator.Interpolator) {
 	//   default = aws_ses_domain_mail_from.SSWXE.id
 	// }
 	if !w.opts.Interpolate ||
-		(w.opts.HasModule() && len(w.opts.ModuleVariables) == 0) {
+		(w.opts.HasModule() && len(w.opts.ModuleVariables) == 0 && !w.opts.ModuleWithoutVariables) {
 		return
 	}
 	// who's interpolated with who
@@ -965,6 +965,45 @@ func TestHCLWriter_Interpolate(t *testing.T) {
 
 		assert.NotContains(t, string(b), "network = aType.aName.id")
 	})
+	t.Run("SuccessWithModuleWithoutVariables", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			value = map[string]interface{}{
+				"network": "to-be-interpolated",
+			}
+			network = map[string]interface{}{
+				"id": "interpolated",
+			}
+			i = interpolator.New("aws")
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().Version().Return("4.9.0")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Module: "test", ModuleWithoutVariables: true, Interpolate: true})
+		i.AddResourceAttributes("aType.aName", map[string]string{
+			"id": "to-be-interpolated",
+		})
+		hw.Write("type.name", value)
+		hw.Write("aType.aName", network)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		// The references are kept inside of the
+		// module, which has no variables to set
+		assert.Contains(t, string(b), "network = aType.aName.id")
+		assert.Contains(t, string(b), "source = \"./module-test\"")
+		assert.NotContains(t, string(b), "variable")
+		assert.NotContains(t, string(b), "module.test")
+	})
 	t.Run("SuccessWithModuleVariablesNotSelected", func(t *testing.T) {
 		var (
 			mw    = mxwriter.NewMux()
@@ -0,0 +1,199 @@
+package huaweicloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+)
+
+// BatchProvider is the provider.Provider returned by NewProvider
+// which can also read many resource types at once
+type BatchProvider interface {
+	provider.Provider
+
+	// ReaderDurations returns the wall-clock duration of the reader
+	// of each resource type read, indexed by type
+	ReaderDurations() map[string]time.Duration
+
+	// ResourcesBatch returns the resources of all the types, grouped by
+	// type. The types are read sharing the same cache so the references
+	// between them are resolved, and concurrently if the
+	// Options.BatchConcurrency is defined, after the shared ones
+	// with the Options.ParallelTypesOnly. The types that fail with
+	// an errcode.ErrProviderAPI are logged and not on the result, and
+	// so are the types not read yet if the ctx is interrupted.
+	// With the Options.RMS the types supported by the RMS are read
+	// from its inventory instead, if it's available, and with the
+	// Options.ResourceQL only the resources it selects are returned
+	ResourcesBatch(ctx context.Context, types []string, f *filter.Filter) (map[string][]provider.Resource, error)
+}
+
+func (p *huaweicloudProvider) ResourcesBatch(ctx context.Context, types []string, f *filter.Filter) (map[string][]provider.Resource, error) {
+	for _, t := range types {
+		rt, err := ResourceTypeString(t)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateEnterpriseProjectType(p.options, rt); err != nil {
+			return nil, err
+		}
+	}
+
+	// The ResourceQL replaces the readers of all the types
+	if p.options.ResourceQL != "" {
+		return resourceQLResources(ctx, p, types, f)
+	}
+
+	res := make(map[string][]provider.Resource, len(types))
+
+	// The types supported by the RMS are read from it at once
+	// and only the rest are read from their service API
+	if p.options.RMS {
+		rres, ok, err := rmsResources(ctx, p, types, f)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			pending := make([]string, 0, len(types))
+			for _, t := range types {
+				if rs, ok := rres[t]; ok {
+					res[t] = rs
+					continue
+				}
+				pending = append(pending, t)
+			}
+			types = pending
+		}
+	}
+
+	// The types that are not implemented are warned before
+	// reading the rest as nothing will be imported for them
+	for _, t := range types {
+		if !isStubbed(ResourceType(t)) {
+			continue
+		}
+
+		msg := "the resource type is not implemented, no resources will be imported for it"
+		if isRMSResourceType(ResourceType(t)) {
+			msg += ", it can only be imported from the RMS"
+		}
+		readerLogger(p, t).Log("func", "huaweicloud.ResourcesBatch", "msg", msg)
+	}
+
+	concurrency := p.options.BatchConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// With the Options.ParallelTypesOnly the types shared through
+	// the cache are read one by one before the rest, so each cache
+	// is populated once and before the types referencing it
+	phases := []batchPhase{{types: types, concurrency: concurrency}}
+	if p.options.ParallelTypesOnly {
+		var shared, rest []string
+		for _, t := range types {
+			if isCached(ResourceType(t)) {
+				shared = append(shared, t)
+				continue
+			}
+			rest = append(rest, t)
+		}
+		phases = []batchPhase{
+			{types: shared, concurrency: 1},
+			{types: rest, concurrency: concurrency},
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		rerr error
+
+		// read is the number of types started
+		read int
+	)
+
+	for _, ph := range phases {
+		sem := make(chan struct{}, ph.concurrency)
+
+		for _, t := range ph.types {
+			sem <- struct{}{}
+
+			mu.Lock()
+			failed := rerr != nil
+			mu.Unlock()
+			if failed {
+				<-sem
+				break
+			}
+
+			// The interrupted batch waits for the types being
+			// read but does not start reading the next ones
+			if provider.IsInterrupted(ctx) {
+				<-sem
+				break
+			}
+
+			read++
+			wg.Add(1)
+			go func(t string) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				rs, err := p.Resources(ctx, t, f)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				if err != nil {
+					if errors.Is(err, errcode.ErrProviderAPI) {
+						readerLogger(p, t).Log("func", "huaweicloud.ResourcesBatch", "msg", fmt.Sprintf("unable to read the resources: %s", err.Error()))
+						return
+					}
+					if rerr == nil {
+						rerr = err
+						cancel()
+					}
+					return
+				}
+
+				res[t] = rs
+			}(t)
+		}
+
+		wg.Wait()
+	}
+
+	if rerr == nil && provider.IsInterrupted(ctx) && read < len(types) {
+		kitlog.With(p.baseLogger(), "region", p.Region()).Log("func", "huaweicloud.ResourcesBatch", "msg", fmt.Sprintf("interrupted, %d resource types were not read", len(types)-read))
+	}
+
+	if p.options.Timings {
+		p.logReaderDurations()
+	}
+
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	return res, nil
+}
+
+// batchPhase are the types read at once by the ResourcesBatch,
+// with at most concurrency of them at the same time
+type batchPhase struct {
+	types       []string
+	concurrency int
+}
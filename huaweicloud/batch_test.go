@@ -0,0 +1,251 @@
+package huaweicloud
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcesBatch(t *testing.T) {
+	types := []string{string(VPC), string(VPCSubnet), string(SecurityGroup), string(GaussDBOpenGauss), string(SFSFileSystem)}
+
+	tcs := []struct {
+		Name        string
+		Concurrency int
+	}{
+		{Name: "Sequential"},
+		{Name: "Concurrent", Concurrency: 3},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				hp   = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			hp.options.BatchConcurrency = tc.Concurrency
+
+			// The shared types may be read more than once
+			// when many types read them at the same time
+			r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil).MinTimes(1)
+			r.EXPECT().ListSubnets(gomock.Any(), reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil).MinTimes(1)
+			r.EXPECT().ListSecurityGroups(gomock.Any(), reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "db"}}, "", nil).MinTimes(1)
+			r.EXPECT().ListGaussDBOpenGaussInstances(gomock.Any(), reader.Page{}).Return([]reader.GaussDBOpenGaussInstance{
+				{ID: "gauss-1", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+			}, "", nil)
+			r.EXPECT().ListSFSShares(gomock.Any(), reader.Page{}).Return([]reader.SFSShare{{ID: "sfs-1", Status: "available"}}, "", nil)
+			r.EXPECT().ListSFSAccessRules(gomock.Any(), "sfs-1").Return([]reader.SFSAccessRule{
+				{ID: "rule-1", AccessType: reader.SFSAccessTypeVPC, AccessTo: "vpc-1"},
+			}, nil)
+
+			var p BatchProvider = hp
+			res, err := p.ResourcesBatch(ctx, types, &filter.Filter{})
+			require.NoError(t, err)
+			require.Len(t, res, len(types))
+
+			require.Len(t, res[string(VPC)], 1)
+			assert.Equal(t, "vpc-1", res[string(VPC)][0].ID())
+			require.Len(t, res[string(VPCSubnet)], 1)
+			require.Len(t, res[string(SecurityGroup)], 1)
+
+			require.Len(t, res[string(GaussDBOpenGauss)], 1)
+			gauss := res[string(GaussDBOpenGauss)][0]
+			assert.Equal(t, "vpc-1", gauss.Data().Get("vpc_id"))
+			assert.Equal(t, "subnet-1", gauss.Data().Get("subnet_id"))
+			assert.Equal(t, "sg-1", gauss.Data().Get("security_group_id"))
+
+			require.Len(t, res[string(SFSFileSystem)], 1)
+			assert.Equal(t, "vpc-1", res[string(SFSFileSystem)][0].Data().Get("access_to"))
+		})
+	}
+
+	t.Run("ParallelTypesOnly", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			hp   = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		hp.options.BatchConcurrency = 3
+		hp.options.ParallelTypesOnly = true
+
+		// The shared types are read once, one by one
+		// and before the ones referencing them
+		gomock.InOrder(
+			r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil),
+			r.EXPECT().ListSubnets(gomock.Any(), reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil),
+			r.EXPECT().ListSecurityGroups(gomock.Any(), reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "db"}}, "", nil),
+			r.EXPECT().ListSFSShares(gomock.Any(), reader.Page{}).Return([]reader.SFSShare{{ID: "sfs-1", Status: "available"}}, "", nil),
+			r.EXPECT().ListSFSAccessRules(gomock.Any(), "sfs-1").Return(nil, nil),
+			r.EXPECT().ListGaussDBOpenGaussInstances(gomock.Any(), reader.Page{}).Return([]reader.GaussDBOpenGaussInstance{
+				{ID: "gauss-1", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+			}, "", nil),
+		)
+
+		res, err := hp.ResourcesBatch(ctx, types, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, len(types))
+
+		require.Len(t, res[string(GaussDBOpenGauss)], 1)
+		assert.Equal(t, "vpc-1", res[string(GaussDBOpenGauss)][0].Data().Get("vpc_id"))
+	})
+
+	t.Run("IsCached", func(t *testing.T) {
+		assert.True(t, isCached(VPC))
+		assert.True(t, isCached(SFSFileSystem))
+		assert.False(t, isCached(GaussDBOpenGauss))
+		assert.False(t, isCached(NatGateway))
+
+		// The cache functions of the types, which fill the cache
+		// read by the other types, so their types have to be on the
+		// cachedResourceTypes and have it as reader
+		caches := map[ResourceType]resourceReader{
+			ComputeInstance:   cacheInstances,
+			VPC:               cacheVPCs,
+			VPCSubnet:         cacheVPCSubnets,
+			EIP:               cacheEIPs,
+			SecurityGroup:     cacheSecurityGroups,
+			EVSVolume:         cacheEVSVolumes,
+			OBSBucket:         cacheOBSBuckets,
+			SFSFileSystem:     cacheSFSFileSystems,
+			RDSInstance:       cacheRDSInstances,
+			ASGroup:           cacheASGroups,
+			SMNTopic:          cacheSMNTopics,
+			ELBCertificate:    cacheELBCertificates,
+			DeHHost:           cacheDeHHosts,
+			CFWInstance:       cacheCFWFirewalls,
+			EGEventChannel:    cacheEGEventChannels,
+			DMSKafka:          cacheDMSKafkaInstances,
+			DLIDatabase:       cacheDLIDatabases,
+			IoTDAProduct:      cacheIoTDAProducts,
+			ELBIpGroup:        cacheELBIPGroups,
+			ELBSecurityPolicy: cacheELBSecurityPolicies,
+			ELBL7Policy:       cacheELBL7Policies,
+		}
+
+		// The OBS buckets reader reads the cached ones
+		// and sets the configuration of each bucket
+		readers := map[ResourceType]resourceReader{
+			OBSBucket: obsBuckets,
+		}
+
+		for rt, rr := range resources {
+			if _, ok := caches[rt]; ok {
+				continue
+			}
+			assert.False(t, isCached(rt), rt)
+			for crt, crr := range caches {
+				assert.NotEqual(t, reflect.ValueOf(crr).Pointer(), reflect.ValueOf(rr).Pointer(), "the type %s is read with the cache of %s", rt, crt)
+			}
+		}
+
+		for rt, crr := range caches {
+			assert.True(t, isCached(rt), rt)
+
+			rr, ok := readers[rt]
+			if !ok {
+				rr = crr
+			}
+			assert.Equal(t, reflect.ValueOf(rr).Pointer(), reflect.ValueOf(resources[rt]).Pointer(), rt)
+		}
+		assert.Len(t, cachedResourceTypes, len(caches))
+	})
+
+	t.Run("InvalidType", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		_, err := p.ResourcesBatch(context.Background(), []string{"huaweicloud_unknown"}, &filter.Filter{})
+		assert.Error(t, err)
+	})
+	t.Run("StubbedTypes", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			r      = mock.NewHuaweicloudReader(ctrl)
+			p      = newTestProvider(t, r)
+			ctx    = context.Background()
+			logger = &capturingLogger{}
+		)
+		defer ctrl.Finish()
+
+		p.logger = logger
+
+		r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(NatGateway), string(VPC)}, &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, res[string(NatGateway)], 0)
+		assert.Len(t, res[string(VPC)], 1)
+
+		var warned []interface{}
+		for _, l := range logger.lines {
+			if l["func"] == "huaweicloud.ResourcesBatch" {
+				warned = append(warned, l["resource"])
+			}
+		}
+		assert.Equal(t, []interface{}{string(NatGateway)}, warned)
+	})
+
+	t.Run("StubbedTypesFromRMS", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			r      = mock.NewHuaweicloudReader(ctrl)
+			p      = newTestProvider(t, r)
+			ctx    = context.Background()
+			logger = &capturingLogger{}
+		)
+		defer ctrl.Finish()
+
+		p.logger = logger
+		p.options.RMS = true
+
+		// The NAT gateways are read from the RMS so they are not warned
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return([]reader.RMSResource{
+			{ID: "nat-1", Provider: "nat", Type: "natGateways"},
+		}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(NatGateway)}, &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, res[string(NatGateway)], 1)
+
+		for _, l := range logger.lines {
+			assert.NotEqual(t, "huaweicloud.ResourcesBatch", l["func"])
+		}
+	})
+
+	t.Run("Interrupted", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			r         = mock.NewHuaweicloudReader(ctrl)
+			p         = newTestProvider(t, r)
+			interrupt = make(chan struct{})
+			ctx       = provider.WithInterrupt(context.Background(), interrupt)
+		)
+		defer ctrl.Finish()
+
+		// The VPCs being read when interrupted are kept
+		// but the security groups are not read
+		r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).DoAndReturn(func(context.Context, reader.Page) ([]reader.VPC, string, error) {
+			close(interrupt)
+			return []reader.VPC{{ID: "vpc-1"}}, "", nil
+		})
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC), string(SecurityGroup)}, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Len(t, res[string(VPC)], 1)
+	})
+}
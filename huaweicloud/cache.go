@@ -0,0 +1,1231 @@
+package huaweicloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/cycloidio/terracognita/cache"
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// Quick sum-up of cached resources:
+// ECS: compute_instance
+// EVS: evs_volume
+// VPC: vpc, vpc_subnet, vpc_eip, networking_secgroup
+// RDS: rds_instance
+// AS: as_group
+// SMN: smn_topic
+// SFS: sfs_file_system
+// OBS: obs_bucket
+// ELB: elb_certificate, elb_ipgroup, elb_security_policy, elb_l7policy
+// DeH: deh_instance
+// CFW: cfw_firewall
+// EG: eg_custom_event_channel
+// DMS: dms_kafka_instance
+// DLI: dli_database
+// IoTDA: iotda_product
+// ECS flavors: flavors (flavorsCacheKey)
+// DB flavors: db_flavors_ENGINE (dbFlavorsCacheKey)
+// ELB pools: elb_pools (elbPoolsCacheKey)
+// ELB listeners: elb_listeners (elbListenersCacheKey)
+// IMS private images: ims_images (imsImagesCacheKey)
+
+// syncCache is a cache.Cache safe for concurrent use, as the resource
+// types can be read concurrently by the ResourcesBatch many of them may
+// read the same cached type at the same time so only the first Set is
+// kept and the next ones are ignored
+type syncCache struct {
+	mu    sync.Mutex
+	cache cache.Cache
+}
+
+func newSyncCache(c cache.Cache) cache.Cache {
+	return &syncCache{cache: c}
+}
+
+func (c *syncCache) Set(key string, rs []provider.Resource) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.cache.Set(key, rs)
+	if errors.Is(err, errcode.ErrCacheKeyAlreadyExisting) {
+		return nil
+	}
+	return err
+}
+
+func (c *syncCache) Get(key string) ([]provider.Resource, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.cache.Get(key)
+}
+
+// scopedCache is a cache.Cache that prefixes the keys with the region,
+// the project and a hash of the identity of the credentials (the access
+// key and the auth endpoint), so the same cache can be shared by many
+// providers without mixing the resources of different accounts or clouds.
+// The providers with the same scope share the cached resources, which are
+// read with the provider that cached them
+type scopedCache struct {
+	scope string
+	cache cache.Cache
+}
+
+func newScopedCache(c cache.Cache, region, projectID, accessKey, authURL string) cache.Cache {
+	// The access key is hashed so it's not
+	// written on the keys of the cache
+	h := sha256.Sum256([]byte(accessKey + "\n" + authURL))
+	return &scopedCache{
+		scope: fmt.Sprintf("%s/%s/%x/", region, projectID, h[:8]),
+		cache: c,
+	}
+}
+
+func (c *scopedCache) Set(key string, rs []provider.Resource) error {
+	return c.cache.Set(c.scope+key, rs)
+}
+
+func (c *scopedCache) Get(key string) ([]provider.Resource, error) {
+	return c.cache.Get(c.scope + key)
+}
+
+// instances
+func cacheInstances(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = instances(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get ECS instances")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getInstanceIDs returns the IDs of the ECS instances
+func getInstanceIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheInstances(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// evs_volumes, cached so the volume
+// attachments can reference their volumes
+func cacheEVSVolumes(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = evsVolumes(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get EVS volumes")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// eips
+func cacheEIPs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = eips(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get EIPs")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getEIPIDsByAddress returns the EIP IDs indexed by the public IP address
+func getEIPIDsByAddress(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]string, error) {
+	rs, err := cacheEIPs(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(rs))
+	for _, i := range rs {
+		ids[i.Data().Get("address").(string)] = i.ID()
+	}
+
+	return ids, nil
+}
+
+// vpcs
+func cacheVPCs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = vpcs(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get VPCs")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getVPCIDs returns the IDs of the VPCs
+func getVPCIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheVPCs(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// vpc_subnets
+func cacheVPCSubnets(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = vpcSubnets(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get VPC subnets")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getVPCSubnetIDs returns the IDs of the VPC subnets
+func getVPCSubnetIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheVPCSubnets(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// getVPCSubnetVPCIDs returns the VPC IDs of the VPC subnets indexed by subnet ID
+func getVPCSubnetVPCIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]string, error) {
+	rs, err := cacheVPCSubnets(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = i.Data().Get("vpc_id").(string)
+	}
+
+	return ids, nil
+}
+
+// getVPCSubnetDHCPIDs returns the IDs of the VPC subnets with DHCP enabled
+func getVPCSubnetDHCPIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheVPCSubnets(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		if i.Data().Get("dhcp_enable").(bool) {
+			ids[i.ID()] = struct{}{}
+		}
+	}
+
+	return ids, nil
+}
+
+// networking_secgroups
+func cacheSecurityGroups(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = securityGroups(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get security groups")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getSecurityGroupIDs returns the IDs of the security groups
+func getSecurityGroupIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheSecurityGroups(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// rds_instances
+func cacheRDSInstances(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = rdsInstances(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get RDS instances")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getRDSInstanceIDs returns the IDs of the RDS instances
+func getRDSInstanceIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheRDSInstances(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// rdsMySQLDatastore is the datastore
+// type of the MySQL RDS instances
+const rdsMySQLDatastore = "MySQL"
+
+// getRDSMySQLInstanceIDs returns the IDs of the RDS instances with the
+// rdsMySQLDatastore, the only ones with databases and accounts on the TF
+func getRDSMySQLInstanceIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheRDSInstances(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		if dt, _ := i.Data().Get("db.0.type").(string); dt == rdsMySQLDatastore {
+			ids = append(ids, i.ID())
+		}
+	}
+
+	return ids, nil
+}
+
+// as_groups
+func cacheASGroups(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = asGroups(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get AS groups")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getASGroupIDs returns the IDs of the AS groups
+func getASGroupIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheASGroups(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// smn_topics
+func cacheSMNTopics(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = smnTopics(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get SMN topics")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getSMNTopicURNs returns the URNs of the SMN topics
+func getSMNTopicURNs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]struct{}, error) {
+	rs, err := cacheSMNTopics(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(rs))
+	for _, i := range rs {
+		ids[i.ID()] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// sfs_file_systems
+func cacheSFSFileSystems(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = sfsFileSystems(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get SFS file systems")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getSFSFileSystemIDs returns the IDs of the SFS file systems
+func getSFSFileSystemIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheSFSFileSystems(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// obs_buckets
+func cacheOBSBuckets(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = listOBSBuckets(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get OBS buckets")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// elb_certificates, cached so the listeners
+// can reference their certificates
+func cacheELBCertificates(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = elbCertificates(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get ELB certificates")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// elb_ipgroups, cached so the listeners
+// can reference their IP groups
+func cacheELBIPGroups(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = elbIPGroups(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get ELB IP groups")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getELBListenerIPGroupIDs returns the IDs of the ELB IP
+// groups indexed by the ID of the listeners using them
+func getELBListenerIPGroupIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]string, error) {
+	rs, err := cacheELBIPGroups(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	for _, i := range rs {
+		for _, l := range i.Data().Get("listener_ids").([]interface{}) {
+			ids[l.(string)] = i.ID()
+		}
+	}
+
+	return ids, nil
+}
+
+// elb_security_policies, cached so the
+// listeners can reference their policies
+func cacheELBSecurityPolicies(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = elbSecurityPolicies(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get ELB security policies")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getELBListenerSecurityPolicyIDs returns the IDs of the ELB security
+// policies indexed by the ID of the listeners using them
+func getELBListenerSecurityPolicyIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) (map[string]string, error) {
+	rs, err := cacheELBSecurityPolicies(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	for _, i := range rs {
+		for _, l := range i.Data().Get("listeners").([]interface{}) {
+			ids[l.(map[string]interface{})["id"].(string)] = i.ID()
+		}
+	}
+
+	return ids, nil
+}
+
+// elb_l7policies, cached so the
+// rules can reference their policies
+func cacheELBL7Policies(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = elbL7Policies(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get ELB forwarding policies")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getELBL7PolicyIDs returns the IDs of the ELB forwarding policies
+func getELBL7PolicyIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheELBL7Policies(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// deh_instances
+func cacheDeHHosts(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = dehHosts(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get Dedicated Hosts")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// cfw_firewalls, cached so the protection
+// rules can be read for each one of them
+func cacheCFWFirewalls(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = cfwFirewalls(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get CFW firewalls")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getCFWFirewallObjectIDs returns the IDs of the protected
+// objects of the Internet border of the CFW firewalls
+func getCFWFirewallObjectIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheCFWFirewalls(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		objects, _ := i.Data().Get("protect_objects").([]interface{})
+		for _, o := range objects {
+			om, ok := o.(map[string]interface{})
+			if !ok || om["type"] != reader.CFWProtectObjectTypeInternet {
+				continue
+			}
+			if id, _ := om["object_id"].(string); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// eg_custom_event_channels, cached so the
+// subscriptions can be read for each one of them
+func cacheEGEventChannels(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = egEventChannels(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get EG event channels")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getEGEventChannelIDs returns the IDs of the EG custom event channels
+func getEGEventChannelIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheEGEventChannels(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// dms_kafka_instances, cached so the
+// topics can be read for each one of them
+func cacheDMSKafkaInstances(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = dmsKafkaInstances(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get DMS Kafka instances")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getDMSKafkaInstanceIDs returns the IDs of the DMS Kafka instances
+func getDMSKafkaInstanceIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheDMSKafkaInstances(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// dli_databases, cached so the
+// tables can be read for each one of them
+func cacheDLIDatabases(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = dliDatabases(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get DLI databases")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getDLIDatabaseNames returns the names of the DLI databases
+func getDLIDatabaseNames(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheDLIDatabases(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.ID())
+	}
+
+	return names, nil
+}
+
+// iotda_products, cached so the
+// devices can be read for each one of them
+func cacheIoTDAProducts(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := p.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = iotdaProducts(ctx, p, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get IoTDA products")
+		}
+
+		rs = truncateMaxPerType(filters, rs)
+
+		err = p.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// getIoTDAProductIDs returns the IDs of the IoTDA products
+func getIoTDAProductIDs(ctx context.Context, p *huaweicloudProvider, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheIoTDAProducts(ctx, p, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+// flavorsCacheKey is the key of the cached ECS flavors of the region,
+// which are not a resource type so they can not collide with one
+const flavorsCacheKey = "flavors"
+
+// flavorResource is a cached reader.Flavor, the cache
+// only holds provider.Resource so it's wrapped on one
+type flavorResource struct {
+	provider.Resource
+
+	flavor reader.Flavor
+}
+
+// flavors, cached so the readers needing the flavors (and
+// the AZs on their ExtraSpecs) list them once per region
+func cacheFlavors(ctx context.Context, p *huaweicloudProvider) ([]reader.Flavor, error) {
+	rs, err := p.cache.Get(flavorsCacheKey)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		flavors, err := p.reader.ListFlavors(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list ECS flavors")
+		}
+
+		rs = make([]provider.Resource, 0, len(flavors))
+		for _, f := range flavors {
+			rs = append(rs, &flavorResource{
+				Resource: provider.NewResource(f.ID, string(ComputeInstance), p),
+				flavor:   f,
+			})
+		}
+
+		err = p.cache.Set(flavorsCacheKey, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flavors := make([]reader.Flavor, 0, len(rs))
+	for _, r := range rs {
+		fr, ok := r.(*flavorResource)
+		if !ok {
+			return nil, errors.Errorf("the cached ECS flavor %q is a %T", r.ID(), r)
+		}
+		flavors = append(flavors, fr.flavor)
+	}
+
+	return flavors, nil
+}
+
+// dbFlavorsCacheKeyPrefix is the prefix of the key of the cached
+// DB flavors of an engine, which are not a resource type
+const dbFlavorsCacheKeyPrefix = "db_flavors_"
+
+// dbFlavorsCacheKey returns the key of the cached flavors of the
+// DB engine, each engine has its own flavors
+func dbFlavorsCacheKey(engine string) string {
+	return dbFlavorsCacheKeyPrefix + engine
+}
+
+// dbFlavorResource is a cached reader.DBFlavor, the cache
+// only holds provider.Resource so it's wrapped on one
+type dbFlavorResource struct {
+	provider.Resource
+
+	flavor reader.DBFlavor
+}
+
+// db_flavors_ENGINE, cached so the DB readers validating the
+// flavors list the ones of an engine once per region
+func cacheDBFlavors(ctx context.Context, p *huaweicloudProvider, engine string) ([]reader.DBFlavor, error) {
+	key := dbFlavorsCacheKey(engine)
+
+	rs, err := p.cache.Get(key)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		flavors, err := p.reader.ListRDSFlavors(ctx, engine)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list the DB flavors of %q", engine)
+		}
+
+		rs = make([]provider.Resource, 0, len(flavors))
+		for _, f := range flavors {
+			rs = append(rs, &dbFlavorResource{
+				Resource: provider.NewResource(f.SpecCode, string(RDSInstance), p),
+				flavor:   f,
+			})
+		}
+
+		err = p.cache.Set(key, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flavors := make([]reader.DBFlavor, 0, len(rs))
+	for _, r := range rs {
+		fr, ok := r.(*dbFlavorResource)
+		if !ok {
+			return nil, errors.Errorf("the cached DB flavor %q is a %T", r.ID(), r)
+		}
+		flavors = append(flavors, fr.flavor)
+	}
+
+	return flavors, nil
+}
+
+// elbPoolsCacheKey is the key of the cached ELB pools of the region,
+// which are not a resource type so they can not collide with one
+const elbPoolsCacheKey = "elb_pools"
+
+// elbPoolResource is a cached reader.ELBPool, the cache
+// only holds provider.Resource so it's wrapped on one
+type elbPoolResource struct {
+	provider.Resource
+
+	pool reader.ELBPool
+}
+
+// elb_pools, cached so the readers of the resources
+// attached to the pools (ex: monitors) list them once
+func cacheELBPools(ctx context.Context, p *huaweicloudProvider) ([]reader.ELBPool, error) {
+	rs, err := p.cache.Get(elbPoolsCacheKey)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs = make([]provider.Resource, 0)
+
+		var page reader.Page
+		for {
+			pools, next, err := p.reader.ListELBPools(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list ELB pools")
+			}
+
+			for _, pl := range pools {
+				rs = append(rs, &elbPoolResource{
+					Resource: provider.NewResource(pl.ID, string(ELBMonitor), p),
+					pool:     pl,
+				})
+			}
+
+			if next == "" {
+				break
+			}
+			page.Marker = next
+		}
+
+		err = p.cache.Set(elbPoolsCacheKey, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pools := make([]reader.ELBPool, 0, len(rs))
+	for _, r := range rs {
+		pr, ok := r.(*elbPoolResource)
+		if !ok {
+			return nil, errors.Errorf("the cached ELB pool %q is a %T", r.ID(), r)
+		}
+		pools = append(pools, pr.pool)
+	}
+
+	return pools, nil
+}
+
+// elbListenersCacheKey is the key of the cached ELB listeners of the
+// region, which are not a resource type so they can not collide with one
+const elbListenersCacheKey = "elb_listeners"
+
+// elbListenerResource is a cached reader.ELBListener, the
+// cache only holds provider.Resource so it's wrapped on one
+type elbListenerResource struct {
+	provider.Resource
+
+	listener reader.ELBListener
+}
+
+// elb_listeners, cached so the readers of the resources
+// of the listeners (ex: forwarding policies) list them once
+func cacheELBListeners(ctx context.Context, p *huaweicloudProvider) ([]reader.ELBListener, error) {
+	rs, err := p.cache.Get(elbListenersCacheKey)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs = make([]provider.Resource, 0)
+
+		var page reader.Page
+		for {
+			listeners, next, err := p.reader.ListELBListeners(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list ELB listeners")
+			}
+
+			for _, l := range listeners {
+				rs = append(rs, &elbListenerResource{
+					Resource: provider.NewResource(l.ID, string(ELBL7Policy), p),
+					listener: l,
+				})
+			}
+
+			if next == "" {
+				break
+			}
+			page.Marker = next
+		}
+
+		err = p.cache.Set(elbListenersCacheKey, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listeners := make([]reader.ELBListener, 0, len(rs))
+	for _, r := range rs {
+		lr, ok := r.(*elbListenerResource)
+		if !ok {
+			return nil, errors.Errorf("the cached ELB listener %q is a %T", r.ID(), r)
+		}
+		listeners = append(listeners, lr.listener)
+	}
+
+	return listeners, nil
+}
+
+// imsImagesCacheKey is the key of the cached private IMS images of
+// the region, which are not a resource type so they can not collide
+const imsImagesCacheKey = "ims_images"
+
+// imsImageResource is a cached reader.IMSImage, the cache
+// only holds provider.Resource so it's wrapped on one
+type imsImageResource struct {
+	provider.Resource
+
+	image reader.IMSImage
+}
+
+// cacheIMSPrivateImages returns the private IMS images of the region,
+// they are listed only once as each one has to be read for its shares
+func cacheIMSPrivateImages(ctx context.Context, p *huaweicloudProvider) ([]reader.IMSImage, error) {
+	rs, err := p.cache.Get(imsImagesCacheKey)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs = make([]provider.Resource, 0)
+
+		var page reader.Page
+		for {
+			images, next, err := p.reader.ListIMSPrivateImages(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list IMS private images")
+			}
+
+			for _, i := range images {
+				rs = append(rs, &imsImageResource{
+					Resource: provider.NewResource(i.ID, string(IMSImageShare), p),
+					image:    i,
+				})
+			}
+
+			if next == "" {
+				break
+			}
+			page.Marker = next
+		}
+
+		err = p.cache.Set(imsImagesCacheKey, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	images := make([]reader.IMSImage, 0, len(rs))
+	for _, r := range rs {
+		ir, ok := r.(*imsImageResource)
+		if !ok {
+			return nil, errors.Errorf("the cached IMS image %q is a %T", r.ID(), r)
+		}
+		images = append(images, ir.image)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,111 @@
+package huaweicloud
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/pkg/errors"
+)
+
+// Catalog is a custom catalog of the regions and the endpoints of their
+// services, for the clouds that do not follow the Huawei Cloud one
+// (ex: Huawei Cloud Stack on-premises)
+type Catalog struct {
+	// Regions are the RegionCatalog of each region, by region name
+	Regions map[string]RegionCatalog `json:"regions"`
+}
+
+// RegionCatalog are the endpoints of a region of the Catalog
+type RegionCatalog struct {
+	// AuthURL, if defined, is the IAM endpoint used to authenticate
+	// (ex: 'https://iam.hcs.example.com/v3'), by default it's the
+	// IAM endpoint of the Cloud
+	AuthURL string `json:"auth_url"`
+
+	// Cloud is the domain of the endpoints of the services that are
+	// not on the Endpoints, which are 'https://SERVICE.REGION.CLOUD'
+	Cloud string `json:"cloud"`
+
+	// Endpoints are the URLs of the services, by the name
+	// of the service on the TF Provider (ex: 'ecs', 'vpc')
+	Endpoints map[string]string `json:"endpoints"`
+}
+
+// validate checks that the Catalog has regions
+// and that all of them are valid
+func (c Catalog) validate() error {
+	if len(c.Regions) == 0 {
+		return errors.New("invalid catalog, it has no regions")
+	}
+
+	for name, rc := range c.Regions {
+		if name == "" {
+			return errors.New("invalid catalog, a region has no name")
+		}
+		if err := rc.validate(); err != nil {
+			return errors.Wrapf(err, "invalid catalog of the region %q", name)
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the RegionCatalog has the Cloud or the Endpoints,
+// that the URLs are valid and that the services are on the TF Provider
+func (rc RegionCatalog) validate() error {
+	if rc.Cloud == "" && len(rc.Endpoints) == 0 {
+		return errors.New("it has no cloud nor endpoints")
+	}
+
+	if strings.Contains(rc.Cloud, "/") {
+		return errors.Errorf("invalid cloud %q, it has to be a domain (ex: 'hcs.example.com')", rc.Cloud)
+	}
+
+	if rc.AuthURL != "" {
+		if err := validateCatalogURL(rc.AuthURL); err != nil {
+			return errors.Wrap(err, "invalid auth_url")
+		}
+	}
+
+	for srv, u := range rc.Endpoints {
+		if config.GetServiceCatalog(srv) == nil {
+			return errors.Errorf("unknown service %q on the endpoints", srv)
+		}
+		if err := validateCatalogURL(u); err != nil {
+			return errors.Wrapf(err, "invalid endpoint of the service %q", srv)
+		}
+	}
+
+	return nil
+}
+
+// validateCatalogURL checks that u is an HTTP(S) URL with host
+func validateCatalogURL(u string) error {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL %q", u)
+	}
+	if (pu.Scheme != "http" && pu.Scheme != "https") || pu.Host == "" {
+		return errors.Errorf("invalid URL %q, the expected format is 'http(s)://HOST'", u)
+	}
+	return nil
+}
+
+// setTFConfig sets the endpoints of the RegionCatalog on the configuration
+// of the TF Provider, which resolves the service endpoints with them
+func (rc RegionCatalog) setTFConfig(cfg map[string]interface{}) {
+	if rc.AuthURL != "" {
+		cfg["auth_url"] = rc.AuthURL
+	}
+	if rc.Cloud != "" {
+		cfg["cloud"] = rc.Cloud
+	}
+	if len(rc.Endpoints) != 0 {
+		endpoints := make(map[string]interface{}, len(rc.Endpoints))
+		for srv, u := range rc.Endpoints {
+			endpoints[srv] = u
+		}
+		cfg["endpoints"] = endpoints
+	}
+}
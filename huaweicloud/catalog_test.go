@@ -0,0 +1,104 @@
+package huaweicloud
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	const catalogJSON = `{
+		"regions": {
+			"hcs-1": {
+				"auth_url": "https://iam.hcs.example.com/v3",
+				"cloud": "hcs.example.com",
+				"endpoints": {
+					"ecs": "https://ecs.hcs.example.com",
+					"vpc": "https://network.hcs.example.com/"
+				}
+			}
+		}
+	}`
+
+	var c Catalog
+	require.NoError(t, json.Unmarshal([]byte(catalogJSON), &c))
+
+	t.Run("ResolvesEndpoints", func(t *testing.T) {
+		p, err := NewProvider(context.Background(), "hcs-1", "123456", "access", "secret", "", Options{Catalog: &c})
+		require.NoError(t, err)
+
+		hp := p.(*huaweicloudProvider)
+		assert.Equal(t, "https://iam.hcs.example.com/v3", hp.tfClient.(map[string]interface{})["auth_url"])
+
+		cfg, err := hp.configure(context.Background())
+		require.NoError(t, err)
+
+		// The services on the catalog use its endpoints
+		// and the rest are on the domain of the cloud
+		for srv, endpoint := range map[string]string{
+			"ecs": "https://ecs.hcs.example.com/",
+			"vpc": "https://network.hcs.example.com/",
+			"evs": "https://evs.hcs-1.hcs.example.com/",
+		} {
+			client, err := cfg.NewServiceClient(srv, "hcs-1")
+			require.NoError(t, err)
+			assert.Equal(t, endpoint, client.Endpoint, srv)
+		}
+	})
+
+	t.Run("ErrorRegionNotOnCatalog", func(t *testing.T) {
+		_, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{Catalog: &c})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not on the catalog")
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tcs := []struct {
+			Name    string
+			Catalog Catalog
+			Err     string
+		}{
+			{
+				Name:    "NoRegions",
+				Catalog: Catalog{},
+				Err:     "it has no regions",
+			},
+			{
+				Name:    "NoCloudNorEndpoints",
+				Catalog: Catalog{Regions: map[string]RegionCatalog{"hcs-1": {AuthURL: "https://iam.hcs.example.com/v3"}}},
+				Err:     "it has no cloud nor endpoints",
+			},
+			{
+				Name:    "CloudURL",
+				Catalog: Catalog{Regions: map[string]RegionCatalog{"hcs-1": {Cloud: "https://hcs.example.com"}}},
+				Err:     "invalid cloud",
+			},
+			{
+				Name:    "AuthURL",
+				Catalog: Catalog{Regions: map[string]RegionCatalog{"hcs-1": {AuthURL: "iam.hcs.example.com", Cloud: "hcs.example.com"}}},
+				Err:     "invalid auth_url",
+			},
+			{
+				Name:    "UnknownService",
+				Catalog: Catalog{Regions: map[string]RegionCatalog{"hcs-1": {Endpoints: map[string]string{"unknown": "https://unknown.hcs.example.com"}}}},
+				Err:     "unknown service",
+			},
+			{
+				Name:    "EndpointURL",
+				Catalog: Catalog{Regions: map[string]RegionCatalog{"hcs-1": {Endpoints: map[string]string{"ecs": "ecs.hcs.example.com"}}}},
+				Err:     "invalid endpoint of the service \"ecs\"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.Name, func(t *testing.T) {
+				_, err := NewProvider(context.Background(), "hcs-1", "123456", "access", "secret", "", Options{Catalog: &tc.Catalog})
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.Err)
+			})
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package huaweicloud
+
+import (
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// ChargingMode is the billing mode of a resource,
+// with the values of the 'charging_mode' of the TF provider
+type ChargingMode string
+
+const (
+	// ChargingModePrePaid are the yearly/monthly (reserved) resources
+	ChargingModePrePaid ChargingMode = "prePaid"
+
+	// ChargingModePostPaid are the pay-per-use resources
+	ChargingModePostPaid ChargingMode = "postPaid"
+
+	// ChargingModeSpot are the spot (bidding) ECS instances
+	ChargingModeSpot ChargingMode = "spot"
+)
+
+// serverChargingModes are the charging modes by the
+// 'charging_mode' metadata of the ECS instances
+var serverChargingModes = map[string]ChargingMode{
+	"0": ChargingModePostPaid,
+	"1": ChargingModePrePaid,
+	"2": ChargingModeSpot,
+}
+
+// chargingModeTypes are the billable resource
+// types filtered by the Options.ChargingMode
+var chargingModeTypes = map[ResourceType]struct{}{
+	ComputeInstance: {},
+	RDSInstance:     {},
+	EVSVolume:       {},
+	EIP:             {},
+}
+
+// chargingModeMatches checks if the charging mode m of a resource
+// matches the Options.ChargingMode, the resources without a
+// charging mode always match
+func chargingModeMatches(p *huaweicloudProvider, m ChargingMode) bool {
+	return p.options.ChargingMode == "" || m == "" || m == p.options.ChargingMode
+}
+
+// orderChargingMode returns the charging mode of the resources
+// that have an order ID only when they are prePaid
+func orderChargingMode(orderID string) ChargingMode {
+	if orderID != "" {
+		return ChargingModePrePaid
+	}
+	return ChargingModePostPaid
+}
+
+// serverCharging is the billing of an ECS instance,
+// the Spot is only defined for the spot instances
+type serverCharging struct {
+	Mode ChargingMode
+	Spot reader.ServerSpotOptions
+}
+
+// serverChargingMode returns the charging mode of the ECS instance s,
+// the instances without the metadata are pay-per-use
+func serverChargingMode(s reader.Server) ChargingMode {
+	if m, ok := serverChargingModes[s.Metadata["charging_mode"]]; ok {
+		return m
+	}
+	return ChargingModePostPaid
+}
+
+// setServerCharging sets the 'charging_mode' and, for the spot
+// instances, the 'spot_*' of the ECS instance r with the c
+func setServerCharging(r provider.Resource, c serverCharging) error {
+	if err := r.Data().Set("charging_mode", string(c.Mode)); err != nil {
+		return errors.Wrapf(err, "unable to set charging_mode data on the provider.Resource for the ECS instance %q", r.ID())
+	}
+
+	if c.Mode != ChargingModeSpot {
+		return nil
+	}
+
+	// The maximum price conflicts with the duration
+	// so it's only set without a predefined duration
+	spot := map[string]interface{}{}
+	if c.Spot.SpotDurationHours != 0 {
+		spot["spot_duration"] = c.Spot.SpotDurationHours
+		spot["spot_duration_count"] = c.Spot.SpotDurationCount
+	} else if c.Spot.SpotPrice != "" {
+		spot["spot_maximum_price"] = c.Spot.SpotPrice
+	}
+
+	for k, v := range spot {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the ECS instance %q", k, r.ID())
+		}
+	}
+
+	return nil
+}
+
+// fixComputeInstanceCharging sets the 'charging_mode' and the 'spot_*'
+// of the instance v from its registered billing, as the provider does
+// not read the bidding options. The 'spot_*' of the instances that are
+// not spot ones are removed, so they are not imported as on-demand ones
+func fixComputeInstanceCharging(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") {
+		return v
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v
+	}
+
+	p.instanceChargingMu.Lock()
+	c, ok := p.instanceCharging[id.AsString()]
+	p.instanceChargingMu.Unlock()
+	if !ok {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	if _, ok := vm["charging_mode"]; ok {
+		vm["charging_mode"] = cty.StringVal(string(c.Mode))
+	}
+
+	spot := map[string]cty.Value{
+		"spot_maximum_price":  cty.NullVal(cty.String),
+		"spot_duration":       cty.NullVal(cty.Number),
+		"spot_duration_count": cty.NullVal(cty.Number),
+	}
+	if c.Mode == ChargingModeSpot {
+		if c.Spot.SpotDurationHours != 0 {
+			spot["spot_duration"] = cty.NumberIntVal(int64(c.Spot.SpotDurationHours))
+			spot["spot_duration_count"] = cty.NumberIntVal(int64(c.Spot.SpotDurationCount))
+		} else if c.Spot.SpotPrice != "" {
+			spot["spot_maximum_price"] = cty.StringVal(c.Spot.SpotPrice)
+		}
+	}
+
+	for k, sv := range spot {
+		if _, ok := vm[k]; ok {
+			vm[k] = sv
+		}
+	}
+
+	return cty.ObjectVal(vm)
+}
+
+// serverReadOnlyBillingAttributes are the billing attributes of the
+// ECS instances that are only returned by the API, which fail the
+// 'terraform apply' if they are written on the HCL. The bundled
+// provider has none of them, they are for the newer versions
+var serverReadOnlyBillingAttributes = []string{"order_id", "charging_info"}
+
+// fixComputeInstanceBilling removes the read-only billing attributes
+// of the instance v, even if no billing was registered for it, the
+// settable ones (ex: 'charging_mode' or 'period') are kept
+func fixComputeInstanceBilling(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	for _, k := range serverReadOnlyBillingAttributes {
+		if av, ok := vm[k]; ok {
+			vm[k] = cty.NullVal(av.Type())
+		}
+	}
+
+	return cty.ObjectVal(vm)
+}
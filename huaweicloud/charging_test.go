@@ -0,0 +1,137 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChargingMode(t *testing.T) {
+	servers := []reader.Server{
+		{ID: "ecs-postpaid", Metadata: map[string]string{"charging_mode": "0"}},
+		{ID: "ecs-prepaid", Metadata: map[string]string{"charging_mode": "1"}},
+	}
+	rdsInstances := []reader.RDSInstance{
+		{ID: "rds-postpaid", ChargeInfo: reader.RDSChargeInfo{ChargeMode: "postPaid"}},
+		{ID: "rds-prepaid", ChargeInfo: reader.RDSChargeInfo{ChargeMode: "prePaid"}},
+		{ID: "rds-unknown"},
+	}
+	volumes := []reader.EVSVolume{
+		{ID: "vol-postpaid"},
+		{ID: "vol-prepaid", Metadata: map[string]string{"orderID": "order-1"}},
+	}
+	ips := []reader.EIP{
+		{ID: "eip-postpaid"},
+		{ID: "eip-prepaid", Profile: reader.EIPProfile{OrderID: "order-2"}},
+	}
+
+	tcs := []struct {
+		Name         string
+		ChargingMode ChargingMode
+		IDs          map[ResourceType][]string
+	}{
+		{
+			Name: "All",
+			IDs: map[ResourceType][]string{
+				ComputeInstance: {"ecs-postpaid", "ecs-prepaid"},
+				RDSInstance:     {"rds-postpaid", "rds-prepaid", "rds-unknown"},
+				EVSVolume:       {"vol-postpaid", "vol-prepaid"},
+				EIP:             {"eip-postpaid", "eip-prepaid"},
+			},
+		},
+		{
+			Name:         "PrePaid",
+			ChargingMode: ChargingModePrePaid,
+			IDs: map[ResourceType][]string{
+				ComputeInstance: {"ecs-prepaid"},
+				RDSInstance:     {"rds-prepaid", "rds-unknown"},
+				EVSVolume:       {"vol-prepaid"},
+				EIP:             {"eip-prepaid"},
+			},
+		},
+		{
+			Name:         "PostPaid",
+			ChargingMode: ChargingModePostPaid,
+			IDs: map[ResourceType][]string{
+				ComputeInstance: {"ecs-postpaid"},
+				RDSInstance:     {"rds-postpaid", "rds-unknown"},
+				EVSVolume:       {"vol-postpaid"},
+				EIP:             {"eip-postpaid"},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			p.options.ChargingMode = tc.ChargingMode
+
+			r.EXPECT().ListServers(ctx, reader.Page{}).Return(servers, "", nil)
+			r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+			r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return(rdsInstances, "", nil)
+			r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+			// The volumes are also listed for the disks of the instances
+			r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(volumes, "", nil).Times(2)
+			r.EXPECT().ListEIPs(ctx, reader.Page{}).Return(ips, "", nil)
+
+			for _, rt := range []ResourceType{ComputeInstance, RDSInstance, EVSVolume, EIP} {
+				rs, err := p.Resources(ctx, string(rt), &filter.Filter{})
+				require.NoError(t, err)
+
+				ids := make([]string, 0, len(rs))
+				for _, r := range rs {
+					ids = append(ids, r.ID())
+				}
+				assert.Equal(t, tc.IDs[rt], ids, string(rt))
+			}
+		})
+	}
+}
+
+func TestFixComputeInstanceBilling(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+	)
+	defer ctrl.Finish()
+
+	info := cty.Object(map[string]cty.Type{
+		"charging_mode": cty.String,
+		"expired_time":  cty.String,
+	})
+
+	v, err := p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+		"id":            cty.StringVal("ecs-prepaid"),
+		"charging_mode": cty.StringVal("prePaid"),
+		"period_unit":   cty.StringVal("month"),
+		"period":        cty.NumberIntVal(1),
+		"order_id":      cty.StringVal("order-1"),
+		"charging_info": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"charging_mode": cty.StringVal("prePaid"),
+			"expired_time":  cty.StringVal("2027-01-01T00:00:00Z"),
+		})}),
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, cty.NullVal(cty.String), v.GetAttr("order_id"))
+	assert.Equal(t, cty.NullVal(cty.List(info)), v.GetAttr("charging_info"))
+
+	assert.Equal(t, cty.StringVal("prePaid"), v.GetAttr("charging_mode"))
+	assert.Equal(t, cty.StringVal("month"), v.GetAttr("period_unit"))
+	assert.Equal(t, cty.NumberIntVal(1), v.GetAttr("period"))
+}
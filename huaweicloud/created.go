@@ -0,0 +1,178 @@
+package huaweicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// creationTimeAttributes are the attributes the TF Provider
+// uses for the creation time of the resources, by the
+// number of resource types that have them
+var creationTimeAttributes = []string{"created_at", "create_time", "created_time"}
+
+// creationTimeLayouts are the layouts of the creation times on
+// string attributes, most of them are RFC3339 but some APIs
+// return them without the time zone, which is UTC
+var creationTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// unixMilliThreshold is the lowest Unix time in milliseconds used, the
+// numeric creation times below it are in seconds (until the year 33658)
+const unixMilliThreshold = 1e12
+
+// creationTime returns the creation time of the resource v, which
+// is false if the type has none or its value can not be parsed
+func creationTime(v cty.Value) (time.Time, bool) {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return time.Time{}, false
+	}
+
+	for _, k := range creationTimeAttributes {
+		if !v.Type().HasAttribute(k) {
+			continue
+		}
+
+		av := v.GetAttr(k)
+		if av.IsNull() || !av.IsKnown() {
+			continue
+		}
+
+		switch av.Type() {
+		case cty.String:
+			if t, ok := parseCreationTime(av.AsString()); ok {
+				return t, true
+			}
+		case cty.Number:
+			n, _ := av.AsBigFloat().Int64()
+			if t, ok := unixCreationTime(n); ok {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseCreationTime parses the creation time s, on one of the
+// creationTimeLayouts or as a Unix time, which is false if empty
+func parseCreationTime(s string) (time.Time, bool) {
+	for _, l := range creationTimeLayouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t, true
+		}
+	}
+
+	// Some APIs return the Unix times as strings
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return unixCreationTime(n)
+	}
+
+	return time.Time{}, false
+}
+
+// unixCreationTime returns the creation time of the Unix
+// time n, in seconds or milliseconds, false if not positive
+func unixCreationTime(n int64) (time.Time, bool) {
+	if n <= 0 {
+		return time.Time{}, false
+	}
+	if n >= unixMilliThreshold {
+		return time.UnixMilli(n), true
+	}
+	return time.Unix(n, 0), true
+}
+
+// matchCreatedAfter checks that the resource v of type rt, once read, was
+// created after the Options.CreatedAfter, the resources without creation
+// time only match if the Options.ExcludeWithoutCreationTime is not enabled.
+// The ones with a listed creation time were already checked before being
+// read, by the filterCreatedAfter
+func matchCreatedAfter(p *huaweicloudProvider, rt string, v cty.Value) error {
+	if p.options.CreatedAfter.IsZero() {
+		return nil
+	}
+
+	if _, ok := listedCreationTime(p, rt, valueID(v)); ok {
+		return nil
+	}
+
+	t, ok := creationTime(v)
+	if !ok {
+		if p.options.ExcludeWithoutCreationTime {
+			return errors.WithStack(errcode.ErrProviderResourceCreatedBefore)
+		}
+		return nil
+	}
+
+	if !t.After(p.options.CreatedAfter) {
+		return errors.WithStack(errcode.ErrProviderResourceCreatedBefore)
+	}
+
+	return nil
+}
+
+// setListedCreationTime records the creation time created of the
+// resource id of type rt, as listed by its API, so the resources
+// created before the Options.CreatedAfter are filtered before they
+// are read (see filterCreatedAfter). The creation times that can
+// not be parsed are not recorded
+func setListedCreationTime(p *huaweicloudProvider, rt, id, created string) {
+	if p.options.CreatedAfter.IsZero() {
+		return
+	}
+
+	t, ok := parseCreationTime(created)
+	if !ok {
+		return
+	}
+
+	p.listedCreationTimesMu.Lock()
+	defer p.listedCreationTimesMu.Unlock()
+
+	if p.listedCreationTimes == nil {
+		p.listedCreationTimes = make(map[string]time.Time)
+	}
+	p.listedCreationTimes[rt+"."+id] = t
+}
+
+// listedCreationTime returns the creation time of the resource
+// id of type rt listed by its API, false if none was recorded
+func listedCreationTime(p *huaweicloudProvider, rt, id string) (time.Time, bool) {
+	p.listedCreationTimesMu.Lock()
+	defer p.listedCreationTimesMu.Unlock()
+
+	t, ok := p.listedCreationTimes[rt+"."+id]
+	return t, ok
+}
+
+// filterCreatedAfter returns the resources rs of type rt without the
+// ones which listed creation time is not after the Options.CreatedAfter,
+// so they are not read, the skipped ones are logged. The cache keeps
+// all of them so their children are still listed. The resources
+// without listed creation time are checked once read, by the
+// matchCreatedAfter
+func filterCreatedAfter(p *huaweicloudProvider, rt string, rs []provider.Resource) []provider.Resource {
+	if p.options.CreatedAfter.IsZero() {
+		return rs
+	}
+
+	frs := make([]provider.Resource, 0, len(rs))
+	for _, r := range rs {
+		if t, ok := listedCreationTime(p, rt, r.ID()); ok && !t.After(p.options.CreatedAfter) {
+			logSkipped(p, rt, r.ID(), errcode.ErrProviderResourceCreatedBefore.Error())
+			continue
+		}
+		frs = append(frs, r)
+	}
+
+	return frs
+}
@@ -0,0 +1,178 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatedAfter(t *testing.T) {
+	createdAfter := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	resource := func(k string, v cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id": cty.StringVal("res-1"),
+			k:    v,
+		})
+	}
+
+	tcs := []struct {
+		Name                       string
+		Value                      cty.Value
+		ExcludeWithoutCreationTime bool
+		Err                        error
+	}{
+		{
+			Name:  "CreatedAfter",
+			Value: resource("created_at", cty.StringVal("2024-07-01T10:00:00Z")),
+		},
+		{
+			Name:  "CreatedBefore",
+			Value: resource("created_at", cty.StringVal("2024-05-01T10:00:00+08:00")),
+			Err:   errcode.ErrProviderResourceCreatedBefore,
+		},
+		{
+			Name:  "WithoutTimeZone",
+			Value: resource("create_time", cty.StringVal("2024-07-01 10:00:00")),
+		},
+		{
+			Name:  "UnixMilli",
+			Value: resource("created_time", cty.NumberIntVal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).UnixMilli())),
+			Err:   errcode.ErrProviderResourceCreatedBefore,
+		},
+		{
+			Name:  "UnixSeconds",
+			Value: resource("created_time", cty.NumberIntVal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Unix())),
+		},
+		{
+			Name:  "WithoutCreationTime",
+			Value: resource("name", cty.StringVal("res")),
+		},
+		{
+			Name:  "EmptyCreationTime",
+			Value: resource("created_at", cty.StringVal("")),
+		},
+		{
+			Name:                       "ExcludeWithoutCreationTime",
+			Value:                      resource("name", cty.StringVal("res")),
+			ExcludeWithoutCreationTime: true,
+			Err:                        errcode.ErrProviderResourceCreatedBefore,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+			)
+			defer ctrl.Finish()
+
+			p.options.CreatedAfter = createdAfter
+			p.options.ExcludeWithoutCreationTime = tc.ExcludeWithoutCreationTime
+
+			_, err := p.FixResource(string(VPC), tc.Value)
+			if tc.Err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.Err)
+		})
+	}
+
+	t.Run("Listed", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.CreatedAfter = createdAfter
+
+		r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{
+			{ID: "vpc-old", CreatedAt: "2024-05-01T10:00:00"},
+			{ID: "vpc-new", CreatedAt: "2024-07-01T10:00:00"},
+			{ID: "vpc-unknown"},
+		}, "", nil)
+
+		// The old ones are not returned, so they are not read
+		rs, err := p.Resources(ctx, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		ids := make([]string, 0, len(rs))
+		for _, r := range rs {
+			ids = append(ids, r.ID())
+		}
+		assert.Equal(t, []string{"vpc-new", "vpc-unknown"}, ids)
+
+		// The cache keeps all of them so
+		// they can still be referenced
+		vpcIDs, err := getVPCIDs(ctx, p, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, vpcIDs, 3)
+
+		// The ones without listed creation
+		// time are checked once read
+		_, err = p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{
+			"id":         cty.StringVal("vpc-unknown"),
+			"created_at": cty.StringVal("2024-05-01T10:00:00Z"),
+		}))
+		assert.ErrorIs(t, err, errcode.ErrProviderResourceCreatedBefore)
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.Error(t, Options{ExcludeWithoutCreationTime: true}.validate())
+		assert.NoError(t, Options{CreatedAfter: createdAfter, ExcludeWithoutCreationTime: true}.validate())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+		)
+		defer ctrl.Finish()
+
+		_, err := p.FixResource(string(VPC), resource("created_at", cty.StringVal("2020-01-01T00:00:00Z")))
+		assert.NoError(t, err)
+	})
+}
+
+func TestParseCreationTime(t *testing.T) {
+	tcs := []struct {
+		Name    string
+		Created string
+		Time    time.Time
+	}{
+		{Name: "RFC3339", Created: "2024-07-01T10:00:00Z", Time: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "NumericZone", Created: "2024-07-01T18:00:00+0800", Time: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "Microseconds", Created: "2024-07-01T10:00:00.000000", Time: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "WithoutTimeZone", Created: "2024-07-01 10:00:00", Time: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "UnixMilli", Created: "1719828000000", Time: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "Empty"},
+		{Name: "Invalid", Created: "yesterday"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			ct, ok := parseCreationTime(tc.Created)
+			if tc.Time.IsZero() {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.True(t, tc.Time.Equal(ct), ct.String())
+		})
+	}
+}
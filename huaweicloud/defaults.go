@@ -0,0 +1,19 @@
+package huaweicloud
+
+// defaultSecurityGroupName is the name of the security
+// group created by default on each project
+const defaultSecurityGroupName = "default"
+
+// skipDefault checks if the resource id of resourceType has to be
+// skipped because it's one created by default by Huawei Cloud and
+// Options.IncludeDefaults is not set. All the readers that have
+// default resources must use it so they behave the same way
+func skipDefault(p *huaweicloudProvider, resourceType, id string, isDefault bool) bool {
+	if !isDefault || p.options.IncludeDefaults {
+		return false
+	}
+
+	readerLogger(p, resourceType).Log("func", "huaweicloud.skipDefault", "id", id, "msg", "skipping default resource")
+
+	return true
+}
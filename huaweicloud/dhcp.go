@@ -0,0 +1,121 @@
+package huaweicloud
+
+import (
+	"net"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// defaultDHCPLeaseTime is the lease time of
+// the DHCP of the subnets if not customized
+const defaultDHCPLeaseTime = "24h"
+
+// privateDNSNetwork is the network of the private DNS servers of
+// the regions (ex: '100.125.1.250'), which are the DNS servers
+// of the subnets if not customized
+var privateDNSNetwork = &net.IPNet{IP: net.IPv4(100, 125, 0, 0), Mask: net.CIDRMask(16, 32)}
+
+// subnetDNSServers returns the DNS servers of the subnet s,
+// the DNSList or, if empty, the primary and secondary ones
+func subnetDNSServers(s reader.Subnet) []string {
+	if len(s.DNSList) != 0 {
+		return s.DNSList
+	}
+
+	servers := make([]string, 0, 2)
+	for _, d := range []string{s.PrimaryDNS, s.SecondaryDNS} {
+		if d != "" {
+			servers = append(servers, d)
+		}
+	}
+
+	return servers
+}
+
+// customDNSServers checks if any of the servers
+// is not one of the private DNS servers
+func customDNSServers(servers []string) bool {
+	for _, d := range servers {
+		if ip := net.ParseIP(d); ip == nil || !privateDNSNetwork.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// setSubnetDHCPOptions sets the DNS servers and the lease time
+// of the DHCP of the subnet s on r, only if they are custom
+func setSubnetDHCPOptions(r provider.Resource, s reader.Subnet) error {
+	data := make(map[string]interface{})
+
+	if servers := subnetDNSServers(s); customDNSServers(servers) {
+		list := make([]interface{}, 0, len(servers))
+		for _, d := range servers {
+			list = append(list, d)
+		}
+		data["primary_dns"] = servers[0]
+		if len(servers) > 1 {
+			data["secondary_dns"] = servers[1]
+		}
+		data["dns_list"] = list
+	}
+
+	if s.DHCPLeaseTime != "" && s.DHCPLeaseTime != defaultDHCPLeaseTime {
+		data["dhcp_lease_time"] = s.DHCPLeaseTime
+	}
+
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the VPC subnet %q", k, s.ID)
+		}
+	}
+
+	return nil
+}
+
+// fixVPCSubnetDHCPOptions removes the DNS servers and the lease time of
+// the DHCP of the subnet v when they are the defaults, which the TF
+// provider always reads, so only the custom ones are on the HCL
+func fixVPCSubnetDHCPOptions(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return v
+	}
+
+	vm := v.AsValueMap()
+
+	// As the subnetDNSServers, the 'dns_list' or the
+	// primary and secondary ones if it's empty
+	servers := make([]string, 0)
+	if dl, ok := vm["dns_list"]; ok && !dl.IsNull() && dl.IsKnown() {
+		for it := dl.ElementIterator(); it.Next(); {
+			_, d := it.Element()
+			if !isEmptyString(d) {
+				servers = append(servers, d.AsString())
+			}
+		}
+	}
+	if len(servers) == 0 {
+		for _, k := range []string{"primary_dns", "secondary_dns"} {
+			if d, ok := vm[k]; ok && !isEmptyString(d) {
+				servers = append(servers, d.AsString())
+			}
+		}
+	}
+
+	if !customDNSServers(servers) {
+		for _, k := range []string{"primary_dns", "secondary_dns", "dns_list"} {
+			if d, ok := vm[k]; ok {
+				vm[k] = cty.NullVal(d.Type())
+			}
+		}
+	}
+
+	if lt, ok := vm["dhcp_lease_time"]; ok && !isEmptyString(lt) && lt.AsString() == defaultDHCPLeaseTime {
+		vm["dhcp_lease_time"] = cty.NullVal(lt.Type())
+	}
+
+	return cty.ObjectVal(vm)
+}
@@ -0,0 +1,61 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixVPCSubnetDHCPOptions(t *testing.T) {
+	var (
+		null     = cty.NullVal(cty.String)
+		nullList = cty.NullVal(cty.List(cty.String))
+	)
+
+	subnet := func(primary, secondary, list, lease cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":              cty.StringVal("subnet-1"),
+			"primary_dns":     primary,
+			"secondary_dns":   secondary,
+			"dns_list":        list,
+			"dhcp_lease_time": lease,
+		})
+	}
+	dnsList := func(servers ...string) cty.Value {
+		vs := make([]cty.Value, 0, len(servers))
+		for _, d := range servers {
+			vs = append(vs, cty.StringVal(d))
+		}
+		return cty.ListVal(vs)
+	}
+
+	tcs := []struct {
+		Name   string
+		Value  cty.Value
+		Expect cty.Value
+	}{
+		{
+			Name:   "Defaults",
+			Value:  subnet(cty.StringVal("100.125.1.250"), cty.StringVal("100.125.64.250"), dnsList("100.125.1.250", "100.125.64.250"), cty.StringVal("24h")),
+			Expect: subnet(null, null, nullList, null),
+		},
+		{
+			Name:   "CustomDNSServers",
+			Value:  subnet(cty.StringVal("10.0.0.53"), cty.StringVal("100.125.1.250"), dnsList("10.0.0.53", "100.125.1.250", "8.8.8.8"), cty.StringVal("24h")),
+			Expect: subnet(cty.StringVal("10.0.0.53"), cty.StringVal("100.125.1.250"), dnsList("10.0.0.53", "100.125.1.250", "8.8.8.8"), null),
+		},
+		{
+			Name:   "CustomPrimaryDNSWithoutList",
+			Value:  subnet(cty.StringVal("10.0.0.53"), null, cty.ListValEmpty(cty.String), cty.StringVal("12h")),
+			Expect: subnet(cty.StringVal("10.0.0.53"), null, cty.ListValEmpty(cty.String), cty.StringVal("12h")),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			v := fixVPCSubnetDHCPOptions(tc.Value)
+			assert.True(t, tc.Expect.RawEquals(v), v.GoString())
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package huaweicloud
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// serverDisks are the EVS volumes attached to an ECS instance
+type serverDisks struct {
+	System *reader.EVSVolume
+
+	// Data are the data disks only attached to the
+	// instance, sorted by device (ex: '/dev/vdb')
+	Data []reader.EVSVolume
+}
+
+// listServerDisks returns the disks of each one of the ECS
+// instances of the region, indexed by instance ID
+func listServerDisks(ctx context.Context, p *huaweicloudProvider) (map[string]serverDisks, error) {
+	disks := make(map[string]serverDisks)
+	devices := make(map[string]string)
+
+	var page reader.Page
+	for {
+		volumes, next, err := p.reader.ListEVSVolumes(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list EVS volumes")
+		}
+
+		for _, v := range volumes {
+			if len(v.Attachments) == 0 {
+				continue
+			}
+
+			a := v.Attachments[0]
+			d := disks[a.ServerID]
+			if v.SystemDisk() {
+				v := v
+				d.System = &v
+			} else if !sharedDataDisk(v) {
+				d.Data = append(d.Data, v)
+				devices[v.ID] = a.Device
+			}
+			disks[a.ServerID] = d
+		}
+
+		if next == "" {
+			break
+		}
+		page.Marker = next
+	}
+
+	for _, d := range disks {
+		sort.Slice(d.Data, func(i, j int) bool {
+			return devices[d.Data[i].ID] < devices[d.Data[j].ID]
+		})
+	}
+
+	return disks, nil
+}
+
+// sharedDataDisk checks if the volume v is attached to more than one
+// ECS instance, which can not be inlined on the 'data_disks' of them
+func sharedDataDisk(v reader.EVSVolume) bool {
+	return len(v.Attachments) > 1
+}
+
+// inlineDataDisk checks if the volume v is imported on the
+// 'data_disks' of its ECS instance instead of as an EVS volume
+func inlineDataDisk(p *huaweicloudProvider, v reader.EVSVolume) bool {
+	return p.options.InlineDataDisks && len(v.Attachments) != 0 && !sharedDataDisk(v)
+}
+
+// setServerDisks sets the system disk of the ECS instance r and,
+// if the Options.InlineDataDisks is enabled, the data disks of it
+func setServerDisks(p *huaweicloudProvider, r provider.Resource, d serverDisks) error {
+	if d.System != nil {
+		if err := r.Data().Set("system_disk_type", d.System.VolumeType); err != nil {
+			return errors.Wrapf(err, "unable to set system_disk_type data on the provider.Resource for the ECS instance %q", r.ID())
+		}
+		if err := r.Data().Set("system_disk_size", d.System.Size); err != nil {
+			return errors.Wrapf(err, "unable to set system_disk_size data on the provider.Resource for the ECS instance %q", r.ID())
+		}
+	}
+
+	if !p.options.InlineDataDisks || len(d.Data) == 0 {
+		return nil
+	}
+
+	dds := make([]interface{}, 0, len(d.Data))
+	for _, v := range d.Data {
+		dds = append(dds, map[string]interface{}{
+			"type": v.VolumeType,
+			"size": v.Size,
+		})
+	}
+
+	if err := r.Data().Set("data_disks", dds); err != nil {
+		return errors.Wrapf(err, "unable to set data_disks data on the provider.Resource for the ECS instance %q", r.ID())
+	}
+
+	return nil
+}
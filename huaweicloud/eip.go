@@ -0,0 +1,169 @@
+package huaweicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// portDeviceOwnerCompute is the prefix of the
+// owner of the ports of the ECS instances
+const portDeviceOwnerCompute = "compute:"
+
+// The 'share_type' of the bandwidth of the EIPs, the dedicated
+// bandwidths are only used by one EIP and are defined with it
+// while the shared ones are referenced by their ID
+const (
+	bandwidthShareTypeDedicated = "PER"
+	bandwidthShareTypeShared    = "WHOLE"
+)
+
+// listPorts returns all the ports of the region, indexed by ID
+func listPorts(ctx context.Context, p *huaweicloudProvider) (map[string]reader.Port, error) {
+	ports := make(map[string]reader.Port)
+
+	var page reader.Page
+	for {
+		ps, next, err := p.reader.ListPorts(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list ports")
+		}
+
+		for _, port := range ps {
+			ports[port.ID] = port
+		}
+
+		if next == "" {
+			break
+		}
+		page.Marker = next
+	}
+
+	return ports, nil
+}
+
+// inlineServerEIPs returns the ID of the EIP of the eipRs set as the
+// 'eip_id' of each ECS instance, indexed by instance ID. Only the
+// instances with one bound EIP have it inlined, as the 'eip_id' is
+// bound to the primary network interface which is not known
+func inlineServerEIPs(eipRs []provider.Resource, ports map[string]reader.Port) map[string]string {
+	bound := make(map[string][]string)
+	for _, eip := range eipRs {
+		portID, _ := eip.Data().Get("port_id").(string)
+		port, ok := ports[portID]
+		if !ok || !strings.HasPrefix(port.DeviceOwner, portDeviceOwnerCompute) {
+			continue
+		}
+		bound[port.DeviceID] = append(bound[port.DeviceID], eip.ID())
+	}
+
+	inline := make(map[string]string, len(bound))
+	for id, eipIDs := range bound {
+		if len(eipIDs) == 1 {
+			inline[id] = eipIDs[0]
+		}
+	}
+
+	return inline
+}
+
+// listInlineServerEIPs returns the inlineServerEIPs of the cached EIPs
+func listInlineServerEIPs(ctx context.Context, p *huaweicloudProvider, f *filter.Filter) (map[string]string, error) {
+	eipRs, err := cacheEIPs(ctx, p, string(EIP), f)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := listPorts(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return inlineServerEIPs(eipRs, ports), nil
+}
+
+// eipBandwidth returns the 'bandwidth' of the EIP ip, which has only
+// the attributes of its share type as the 'id' of a shared bandwidth
+// and the 'name' of a dedicated one can not be set together
+func eipBandwidth(ip reader.EIP) map[string]interface{} {
+	bw := map[string]interface{}{
+		"share_type": ip.BandwidthShareType,
+	}
+
+	switch ip.BandwidthShareType {
+	case bandwidthShareTypeShared:
+		bw["id"] = ip.BandwidthID
+	case bandwidthShareTypeDedicated:
+		bw["name"] = ip.BandwidthName
+		bw["size"] = ip.BandwidthSize
+	}
+
+	return bw
+}
+
+// setEIPBandwidth sets the eipBandwidth of ip as the 'bandwidth' of r,
+// the EIPs without bandwidth share type are not changed
+func setEIPBandwidth(r provider.Resource, ip reader.EIP) error {
+	if ip.BandwidthShareType == "" {
+		return nil
+	}
+
+	if err := r.Data().Set("bandwidth", []interface{}{eipBandwidth(ip)}); err != nil {
+		return errors.Wrapf(err, "unable to set bandwidth data on the provider.Resource for the EIP %q", ip.ID)
+	}
+
+	return nil
+}
+
+// fixEIPBandwidth removes from the 'bandwidth' of the EIP v the attributes
+// that are not of its share type, as the TF provider reads all of them but
+// the 'id' conflicts with the 'name' and the shared bandwidths are not
+// defined on their EIPs so the plan would try to change them. The 'id' of
+// the dedicated bandwidths is removed too as it's created with the EIP
+func fixEIPBandwidth(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("bandwidth") {
+		return v
+	}
+
+	bws := v.GetAttr("bandwidth")
+	if bws.IsNull() || !bws.IsKnown() || bws.LengthInt() != 1 {
+		return v
+	}
+
+	bw := bws.Index(cty.NumberIntVal(0))
+	if bw.IsNull() || !bw.Type().IsObjectType() || !bw.Type().HasAttribute("share_type") {
+		return v
+	}
+
+	st := bw.GetAttr("share_type")
+	if isEmptyString(st) {
+		return v
+	}
+
+	var keys []string
+	switch st.AsString() {
+	case bandwidthShareTypeShared:
+		keys = []string{"name", "size", "charge_mode"}
+	case bandwidthShareTypeDedicated:
+		keys = []string{"id"}
+	default:
+		return v
+	}
+
+	bwm := bw.AsValueMap()
+	for _, k := range keys {
+		if a, ok := bwm[k]; ok {
+			bwm[k] = cty.NullVal(a.Type())
+		}
+	}
+
+	vm := v.AsValueMap()
+	vm["bandwidth"] = cty.ListVal([]cty.Value{cty.ObjectVal(bwm)})
+
+	return cty.ObjectVal(vm)
+}
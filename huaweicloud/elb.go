@@ -0,0 +1,26 @@
+package huaweicloud
+
+import "github.com/hashicorp/go-cty/cty"
+
+// elbCertificatePrivateKeys are the attributes of the ELB
+// certificates with the private keys, which are never
+// returned by the API
+var elbCertificatePrivateKeys = []string{"private_key", "enc_private_key"}
+
+// fixELBCertificatePrivateKey removes the private keys of the ELB
+// certificate v, as they are never read they would be written
+// empty and the 'terraform apply' would try to update them
+func fixELBCertificatePrivateKey(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	for _, k := range elbCertificatePrivateKeys {
+		if _, ok := vm[k]; ok {
+			vm[k] = cty.NullVal(cty.String)
+		}
+	}
+
+	return cty.ObjectVal(vm)
+}
@@ -0,0 +1,27 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixELBCertificatePrivateKey(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	v, err := p.FixResource(string(ELBCertificate), cty.ObjectVal(map[string]cty.Value{
+		"id":              cty.StringVal("cert-server"),
+		"certificate":     cty.StringVal("-----BEGIN CERTIFICATE-----"),
+		"private_key":     cty.StringVal(""),
+		"enc_certificate": cty.StringVal(""),
+		"enc_private_key": cty.StringVal(""),
+	}))
+	require.NoError(t, err)
+
+	assert.True(t, v.GetAttr("private_key").IsNull())
+	assert.True(t, v.GetAttr("enc_private_key").IsNull())
+	assert.Equal(t, cty.StringVal("-----BEGIN CERTIFICATE-----"), v.GetAttr("certificate"))
+	assert.Equal(t, cty.StringVal(""), v.GetAttr("enc_certificate"))
+}
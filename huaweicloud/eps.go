@@ -0,0 +1,92 @@
+package huaweicloud
+
+import (
+	"context"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// enterpriseProjectResourceTypes are the resource types which readers
+// list the resources of each enterprise project, the rest can not be
+// scoped so they are not imported if the import is scoped by them
+var enterpriseProjectResourceTypes = map[ResourceType]struct{}{
+	ComputeInstance: {},
+	VPC:             {},
+	EIP:             {},
+	SecurityGroup:   {},
+}
+
+// isEnterpriseProjectScoped checks if the import is scoped by
+// enterprise project, with one or all of them
+func (o Options) isEnterpriseProjectScoped() bool {
+	return o.EnterpriseProjectID != "" || o.AllEnterpriseProjects
+}
+
+// validateEnterpriseProjectType checks that the resource type rt can
+// be imported with the Options o, which is always unless the import
+// is scoped by enterprise project and rt can not be
+func validateEnterpriseProjectType(o Options, rt ResourceType) error {
+	if !o.isEnterpriseProjectScoped() {
+		return nil
+	}
+	if _, ok := enterpriseProjectResourceTypes[rt]; !ok {
+		return errors.Errorf("the resource type %q can not be scoped by enterprise project", rt)
+	}
+	return nil
+}
+
+// enterpriseProjectIDs returns the IDs of the enterprise projects to
+// import one by one, which are all the ones the caller can see with
+// Options.AllEnterpriseProjects. If no enterprise project is
+// defined it returns an empty ID so the import is not scoped
+func enterpriseProjectIDs(ctx context.Context, p *huaweicloudProvider) ([]string, error) {
+	if p.options.EnterpriseProjectID != "" {
+		return []string{p.options.EnterpriseProjectID}, nil
+	}
+
+	if !p.options.AllEnterpriseProjects {
+		return []string{""}, nil
+	}
+
+	p.enterpriseProjectsOnce.Do(func() {
+		ids := make([]string, 0)
+
+		var page reader.Page
+		for {
+			eps, next, err := p.reader.ListEnterpriseProjects(ctx, page)
+			if err != nil {
+				p.enterpriseProjectsErr = errors.Wrap(err, "unable to list enterprise projects")
+				return
+			}
+
+			for _, ep := range eps {
+				ids = append(ids, ep.ID)
+			}
+
+			if next == "" {
+				break
+			}
+			page.Marker = next
+		}
+
+		p.enterpriseProjects = ids
+	})
+
+	return p.enterpriseProjects, p.enterpriseProjectsErr
+}
+
+// setEnterpriseProjectID sets the enterprise project epsID
+// on the resource r, if the import is scoped by one
+func setEnterpriseProjectID(r provider.Resource, epsID string) error {
+	if epsID == "" {
+		return nil
+	}
+
+	if err := r.Data().Set("enterprise_project_id", epsID); err != nil {
+		return errors.Wrapf(err, "unable to set enterprise_project_id data on the provider.Resource for the %s %q", r.Type(), r.ID())
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package huaweicloud
+
+import (
+	"context"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// registerDHCPSubnets registers the VPC subnets with DHCP enabled, so
+// the IPs of the ECS instances on them are not pinned on the HCL
+func registerDHCPSubnets(ctx context.Context, p *huaweicloudProvider, f *filter.Filter) error {
+	ids, err := getVPCSubnetDHCPIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return err
+	}
+
+	p.dhcpSubnetsMu.Lock()
+	defer p.dhcpSubnetsMu.Unlock()
+	for id := range ids {
+		p.dhcpSubnets[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// fixComputeInstanceFixedIPs removes the 'fixed_ip_v4' of the 'network'
+// of the instance v which are on a subnet with DHCP enabled, as the API
+// does not tell if they were assigned by the DHCP or manually. The IPs of
+// the subnets without DHCP, which can only be manual, are kept so they
+// are not reassigned
+func fixComputeInstanceFixedIPs(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if p.options.PinDHCPIPs || v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("network") {
+		return v
+	}
+
+	nets := v.GetAttr("network")
+	if nets.IsNull() || !nets.IsKnown() || !nets.Type().IsListType() || nets.LengthInt() == 0 {
+		return v
+	}
+
+	p.dhcpSubnetsMu.Lock()
+	defer p.dhcpSubnetsMu.Unlock()
+
+	var changed bool
+	l := make([]cty.Value, 0, nets.LengthInt())
+	for it := nets.ElementIterator(); it.Next(); {
+		_, n := it.Element()
+		if n.IsNull() || !n.Type().HasAttribute("uuid") || !n.Type().HasAttribute("fixed_ip_v4") || isEmptyString(n.GetAttr("uuid")) {
+			l = append(l, n)
+			continue
+		}
+
+		if _, ok := p.dhcpSubnets[n.GetAttr("uuid").AsString()]; !ok {
+			l = append(l, n)
+			continue
+		}
+
+		nm := n.AsValueMap()
+		nm["fixed_ip_v4"] = cty.NullVal(cty.String)
+		l = append(l, cty.ObjectVal(nm))
+		changed = true
+	}
+	if !changed {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	vm["network"] = cty.ListVal(l)
+
+	return cty.ObjectVal(vm)
+}
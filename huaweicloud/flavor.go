@@ -0,0 +1,188 @@
+package huaweicloud
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// availableFlavors returns the ECS flavors of the region that
+// can still be used, indexed by flavor ID
+func availableFlavors(ctx context.Context, p *huaweicloudProvider) (map[string]reader.Flavor, error) {
+	flavors, err := cacheFlavors(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]reader.Flavor, len(flavors))
+	for _, f := range flavors {
+		if f.Available() {
+			available[f.ID] = f
+		}
+	}
+
+	return available, nil
+}
+
+// validateServerFlavor checks that the flavor of the Server s is on the
+// available flavors and, if not, logs a warning and registers the
+// substitute flavor when the Options.FlavorValidation requires it
+func validateServerFlavor(p *huaweicloudProvider, s reader.Server, available map[string]reader.Flavor) {
+	if _, ok := available[s.Flavor.ID]; ok {
+		return
+	}
+
+	logger := readerLogger(p, string(ComputeInstance))
+	logger.Log("func", "huaweicloud.validateServerFlavor", "instance", s.ID, "flavor", s.Flavor.ID, "msg", "the flavor of the instance is no longer available, 'terraform plan' may fail")
+
+	if p.options.FlavorValidation != FlavorValidationSubstitute {
+		return
+	}
+
+	nf, ok := nearestFlavor(s.Flavor, available)
+	if !ok {
+		logger.Log("func", "huaweicloud.validateServerFlavor", "instance", s.ID, "flavor", s.Flavor.ID, "msg", "no available flavor to substitute with")
+		return
+	}
+
+	logger.Log("func", "huaweicloud.validateServerFlavor", "instance", s.ID, "flavor", s.Flavor.ID, "substitute", nf.ID, "msg", "substituting the flavor of the instance")
+
+	p.flavorSubstitutesMu.Lock()
+	p.flavorSubstitutes[s.ID] = nf
+	p.flavorSubstitutesMu.Unlock()
+}
+
+// availableDBFlavors returns the flavors of the DB engine (ex: 'mysql')
+// of the region that can still be used, indexed by spec code. They
+// are shared by the DB readers and listed once per engine
+func availableDBFlavors(ctx context.Context, p *huaweicloudProvider, engine string) (map[string]reader.DBFlavor, error) {
+	flavors, err := cacheDBFlavors(ctx, p, strings.ToLower(engine))
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]reader.DBFlavor, len(flavors))
+	for _, f := range flavors {
+		if f.Available() {
+			available[f.SpecCode] = f
+		}
+	}
+
+	return available, nil
+}
+
+// validateDBFlavor checks that the flavor of the DB instance id of the
+// resourceType is on the available flavors of its engine and, if not,
+// logs a warning. The DB flavors are never substituted as they depend
+// on the engine version and on the deployment (single, HA, replica)
+func validateDBFlavor(ctx context.Context, p *huaweicloudProvider, resourceType, id, engine, flavor string) error {
+	if p.options.FlavorValidation == "" {
+		return nil
+	}
+
+	available, err := availableDBFlavors(ctx, p, engine)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := available[flavor]; ok {
+		return nil
+	}
+
+	logger := readerLogger(p, resourceType)
+	logger.Log("func", "huaweicloud.validateDBFlavor", "instance", id, "engine", engine, "flavor", flavor, "msg", "the flavor of the instance is no longer available, 'terraform plan' may fail")
+
+	return nil
+}
+
+// nearestFlavor returns the flavor from the available ones with the
+// closest vCPUs and RAM to sf, the flavors of the same family
+// (ex: 's6' of 's6.large.2') are preferred over the others
+func nearestFlavor(sf reader.ServerFlavor, available map[string]reader.Flavor) (reader.Flavor, bool) {
+	vcpus, _ := strconv.Atoi(sf.VCPUs)
+	ram, _ := strconv.Atoi(sf.RAM)
+	family := flavorFamily(sf.ID)
+
+	candidates := make([]reader.Flavor, 0, len(available))
+	for _, f := range available {
+		candidates = append(candidates, f)
+	}
+	if len(candidates) == 0 {
+		return reader.Flavor{}, false
+	}
+
+	distance := func(f reader.Flavor) (int, int) {
+		fvcpus, _ := strconv.Atoi(f.VCPUs)
+		return abs(fvcpus - vcpus), abs(f.RAM - ram)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if fi, fj := flavorFamily(ci.ID) == family, flavorFamily(cj.ID) == family; fi != fj {
+			return fi
+		}
+		vi, ri := distance(ci)
+		vj, rj := distance(cj)
+		if vi != vj {
+			return vi < vj
+		}
+		if ri != rj {
+			return ri < rj
+		}
+		return ci.ID < cj.ID
+	})
+
+	return candidates[0], true
+}
+
+// flavorFamily returns the family of the flavor id
+// which is the first part of it (ex: 's6' of 's6.large.2')
+func flavorFamily(id string) string {
+	return strings.SplitN(id, ".", 2)[0]
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// fixComputeInstanceFlavor replaces the 'flavor_id' and 'flavor_name'
+// of the instance v if a substitute flavor was registered for it
+func fixComputeInstanceFlavor(p *huaweicloudProvider, v cty.Value) (cty.Value, error) {
+	if v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") {
+		return v, nil
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v, nil
+	}
+
+	p.flavorSubstitutesMu.Lock()
+	nf, ok := p.flavorSubstitutes[id.AsString()]
+	p.flavorSubstitutesMu.Unlock()
+	if !ok {
+		return v, nil
+	}
+
+	return cty.Transform(v, func(path cty.Path, val cty.Value) (cty.Value, error) {
+		if len(path) != 1 {
+			return val, nil
+		}
+		if gas, ok := path[0].(cty.GetAttrStep); ok {
+			switch gas.Name {
+			case "flavor_id":
+				return cty.StringVal(nf.ID), nil
+			case "flavor_name":
+				return cty.StringVal(nf.Name), nil
+			}
+		}
+		return val, nil
+	})
+}
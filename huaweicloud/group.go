@@ -0,0 +1,47 @@
+package huaweicloud
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// GroupByTagDefault is the group of the resources that do
+// not have the tag of the Options.GroupByTag
+const GroupByTagDefault = "untagged"
+
+// ResourceCategory returns the group of the Resource r, which is
+// the value of its Options.GroupByTag tag, so on the HCL each group
+// is written on a different file. It returns false if the
+// Options.GroupByTag is not defined
+func (p *huaweicloudProvider) ResourceCategory(r provider.Resource) (string, bool) {
+	if p.options.GroupByTag == "" {
+		return "", false
+	}
+
+	tags := r.TFResource().Schema[p.TagKey()]
+	if tags == nil {
+		return GroupByTagDefault, true
+	}
+
+	mtags, _ := r.Data().Get(p.TagKey()).(map[string]interface{})
+	v, _ := mtags[p.options.GroupByTag].(string)
+	if g := tagGroup(v); g != "" {
+		return g, true
+	}
+
+	return GroupByTagDefault, true
+}
+
+// tagGroup returns the value v of a tag on a form that can be used
+// as a file name, with the characters other than letters, digits, '-'
+// and '_' replaced with '_'
+func tagGroup(v string) string {
+	return strings.Trim(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, v), "_")
+}
@@ -0,0 +1,96 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceCategory(t *testing.T) {
+	t.Run("NotDefined", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		r := provider.NewResource("vpc-1", string(VPC), p)
+		_, ok := p.ResourceCategory(r)
+		assert.False(t, ok)
+	})
+
+	t.Run("GroupByTag", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		p.options.GroupByTag = "team"
+
+		resources := make([]provider.Resource, 0)
+		for id, tags := range map[string]map[string]interface{}{
+			"vpc-backend":   {"team": "backend"},
+			"vpc-backend-2": {"team": "Backend", "env": "prod"},
+			"vpc-data":      {"team": "data platform"},
+			"vpc-env":       {"env": "prod"},
+			"vpc-untagged":  nil,
+		} {
+			r := provider.NewResource(id, string(VPC), p)
+			if tags != nil {
+				require.NoError(t, r.Data().Set("tags", tags))
+			}
+			resources = append(resources, r)
+		}
+		// The EIP associations have no tags
+		resources = append(resources, provider.NewResource("eip-1", string(EIPAssociate), p))
+
+		groups := make(map[string][]string)
+		for _, r := range resources {
+			g, ok := p.ResourceCategory(r)
+			require.True(t, ok)
+			groups[g] = append(groups[g], r.ID())
+		}
+
+		assert.ElementsMatch(t, []string{"vpc-backend", "vpc-backend-2"}, groups["backend"])
+		assert.ElementsMatch(t, []string{"vpc-data"}, groups["data_platform"])
+		assert.ElementsMatch(t, []string{"vpc-env", "vpc-untagged", "eip-1"}, groups[GroupByTagDefault])
+		assert.Len(t, groups, 3)
+	})
+}
+
+func TestResourceHCLCategory(t *testing.T) {
+	hclConfig := func(t *testing.T, p *huaweicloudProvider) map[string]interface{} {
+		var (
+			ctrl = gomock.NewController(t)
+			w    = mock.NewWriter(ctrl)
+			cfg  map[string]interface{}
+		)
+		defer ctrl.Finish()
+
+		r := provider.NewResource("vpc-1", string(VPC), p)
+		require.NoError(t, r.Data().Set("name", "vpc"))
+		require.NoError(t, r.Data().Set("tags", map[string]interface{}{"team": "backend"}))
+
+		w.EXPECT().Has(gomock.Any()).Return(false, nil)
+		w.EXPECT().Write(gomock.Any(), gomock.Any()).Do(func(_ string, v interface{}) {
+			cfg = v.(map[string]interface{})
+		}).Return(nil)
+
+		require.NoError(t, r.HCL(w))
+		return cfg
+	}
+
+	// Huawei Cloud is not on the docs of the providers so,
+	// without a group, the default category is used
+	t.Run("WithoutDocs", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		cfg := hclConfig(t, p)
+		assert.NotContains(t, cfg, writer.ResourceCategoryKey)
+	})
+
+	t.Run("GroupByTag", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		p.options.GroupByTag = "team"
+
+		cfg := hclConfig(t, p)
+		assert.Equal(t, "backend", cfg[writer.ResourceCategoryKey])
+	})
+}
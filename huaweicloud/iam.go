@@ -0,0 +1,58 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// fixIdentityRolePolicy canonicalizes the JSON 'policy' of the IAM
+// custom policy v, with the keys sorted and on the compact form, so
+// the differences of order and whitespaces returned by the API do
+// not generate diffs between applies
+func fixIdentityRolePolicy(v cty.Value) (cty.Value, error) {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("policy") {
+		return v, nil
+	}
+
+	policy := v.GetAttr("policy")
+	if isEmptyString(policy) {
+		return v, nil
+	}
+
+	np, err := canonicalJSON(policy.AsString())
+	if err != nil {
+		return v, errors.Wrap(err, "invalid IAM custom policy document")
+	}
+
+	vm := v.AsValueMap()
+	vm["policy"] = cty.StringVal(np)
+
+	return cty.ObjectVal(vm), nil
+}
+
+// canonicalJSON returns the JSON document doc with the keys
+// of the objects sorted and without whitespaces
+func canonicalJSON(doc string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+
+	var d interface{}
+	if err := dec.Decode(&d); err != nil {
+		return "", err
+	}
+
+	// The objects are decoded to maps, which are
+	// encoded with the keys sorted
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(d); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
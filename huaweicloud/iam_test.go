@@ -0,0 +1,46 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func identityRole(policy string) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("custom"),
+		"type":   cty.StringVal("AX"),
+		"policy": cty.StringVal(policy),
+	})
+}
+
+func TestFixIdentityRolePolicy(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	t.Run("Equivalent", func(t *testing.T) {
+		v1, err := p.FixResource(string(IdentityRole), identityRole(`{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:object:GetObject"],"Condition":{"StringEquals":{"g:UserName":["a&b"]}}}]}`))
+		require.NoError(t, err)
+
+		v2, err := p.FixResource(string(IdentityRole), identityRole(`{
+  "Statement": [
+    {
+      "Condition": {"StringEquals": {"g:UserName": ["a&b"]}},
+      "Action": ["obs:object:GetObject"],
+      "Effect": "Allow"
+    }
+  ],
+  "Version": "1.1"
+}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, identityRole(`{"Statement":[{"Action":["obs:object:GetObject"],"Condition":{"StringEquals":{"g:UserName":["a&b"]}},"Effect":"Allow"}],"Version":"1.1"}`), v1)
+		assert.Equal(t, v1, v2)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := p.FixResource(string(IdentityRole), identityRole(`{"Version":`))
+		assert.Error(t, err)
+	})
+}
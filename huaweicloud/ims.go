@@ -0,0 +1,92 @@
+package huaweicloud
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// imsMemberRejected is the status of the members
+// of an IMS image that rejected its share
+const imsMemberRejected = "rejected"
+
+// imsImageShares returns the shares of the private IMS images, one per
+// image with all the projects it's shared with which did not reject it.
+// The images, from the cache, that are not shared have no share
+func imsImageShares(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	images, err := cacheIMSPrivateImages(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range images {
+		members, err := p.reader.ListIMSImageMembers(ctx, i.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list the members of the IMS image %q", i.ID)
+		}
+
+		projects := make([]string, 0, len(members))
+		for _, m := range members {
+			if m.Status == imsMemberRejected {
+				continue
+			}
+			projects = append(projects, m.ProjectID)
+		}
+		if len(projects) == 0 {
+			continue
+		}
+		sort.Strings(projects)
+
+		r := provider.NewResource(i.ID, resourceType, p)
+		if err := r.Data().Set("source_image_id", i.ID); err != nil {
+			return nil, errors.Wrapf(err, "unable to set source_image_id data on the provider.Resource for the IMS image share %q", i.ID)
+		}
+		if err := r.Data().Set("target_project_ids", projects); err != nil {
+			return nil, errors.Wrapf(err, "unable to set target_project_ids data on the provider.Resource for the IMS image share %q", i.ID)
+		}
+
+		p.imageSharesMu.Lock()
+		p.imageShares[i.ID] = projects
+		p.imageSharesMu.Unlock()
+
+		r.SetImporter(imsImageShareImporter(p))
+
+		resources = append(resources, r)
+
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+	}
+
+	return resources, nil
+}
+
+// imsImageShareImporter imports the shares of the IMS images with the
+// projects registered by the imsImageShares, as the TF resource can not
+// be imported and its Read does not read anything
+func imsImageShareImporter(p *huaweicloudProvider) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+			p.imageSharesMu.Lock()
+			projects, ok := p.imageShares[d.Id()]
+			p.imageSharesMu.Unlock()
+			if !ok {
+				return nil, errors.Errorf("the IMS image %q is not shared", d.Id())
+			}
+
+			if err := d.Set("source_image_id", d.Id()); err != nil {
+				return nil, err
+			}
+			if err := d.Set("target_project_ids", projects); err != nil {
+				return nil, err
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
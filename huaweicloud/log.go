@@ -0,0 +1,48 @@
+package huaweicloud
+
+import (
+	"github.com/cycloidio/terracognita/log"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// baseLogger returns the logger of the provider, which
+// is the global one unless it was replaced (ex: on tests)
+func (p *huaweicloudProvider) baseLogger() kitlog.Logger {
+	if p.logger != nil {
+		return p.logger
+	}
+	return log.Get()
+}
+
+// readerLogger returns a child logger for the reader of the
+// resourceType, tagged with it and the region so the log lines
+// of each reader can be attributed to it
+func readerLogger(p *huaweicloudProvider, resourceType string) kitlog.Logger {
+	return kitlog.With(p.baseLogger(), "resource", resourceType, "region", p.Region())
+}
+
+// logSkipped logs at debug level the reason why the resource id of the
+// resourceType is not imported, only if the Options.VerboseReaderErrors
+// is enabled, to know why fewer resources than expected were imported
+func logSkipped(p *huaweicloudProvider, resourceType, id, reason string) {
+	if !p.options.VerboseReaderErrors {
+		return
+	}
+
+	level.Debug(readerLogger(p, resourceType)).Log("func", "huaweicloud.logSkipped", "id", id, "reason", reason, "msg", "skipping resource")
+}
+
+// valueID returns the 'id' of the resource v, or "" if it has none
+func valueID(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") {
+		return ""
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return ""
+	}
+	return id.AsString()
+}
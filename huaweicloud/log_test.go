@@ -0,0 +1,162 @@
+package huaweicloud
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturingLogger keeps the log lines as maps of the keyvals
+type capturingLogger struct {
+	mu    sync.Mutex
+	lines []map[string]interface{}
+}
+
+func (l *capturingLogger) Log(keyvals ...interface{}) error {
+	line := make(map[string]interface{}, len(keyvals)/2)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		line[keyvals[i].(string)] = keyvals[i+1]
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, line)
+
+	return nil
+}
+
+var _ kitlog.Logger = (*capturingLogger)(nil)
+
+func TestReaderLogger(t *testing.T) {
+	var (
+		ctrl   = gomock.NewController(t)
+		r      = mock.NewHuaweicloudReader(ctrl)
+		p      = newTestProvider(t, r)
+		ctx    = context.Background()
+		logger = &capturingLogger{}
+	)
+	defer ctrl.Finish()
+
+	p.logger = logger
+
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{
+		{ID: "sg-default", Name: defaultSecurityGroupName},
+		{ID: "sg-1", Name: "web"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(SecurityGroup), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	require.Len(t, logger.lines, 3)
+	for _, l := range logger.lines {
+		assert.Equal(t, string(SecurityGroup), l["resource"])
+		assert.Equal(t, "cn-north-1", l["region"])
+	}
+
+	assert.Equal(t, "huaweicloud.skipDefault", logger.lines[1]["func"])
+	assert.Equal(t, "sg-default", logger.lines[1]["id"])
+
+	assert.Equal(t, "resources read", logger.lines[2]["msg"])
+	assert.Equal(t, 1, logger.lines[2]["count"])
+}
+
+func TestLogSkipped(t *testing.T) {
+	t.Run("Verbose", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			r      = mock.NewHuaweicloudReader(ctrl)
+			p      = newTestProvider(t, r)
+			ctx    = context.Background()
+			logger = &capturingLogger{}
+		)
+		defer ctrl.Finish()
+
+		p.logger = logger
+		p.options.VerboseReaderErrors = true
+
+		r.EXPECT().ListModelArtsNotebooks(ctx, reader.Page{}).Return([]reader.ModelArtsNotebook{
+			{ID: "notebook-running", Status: "RUNNING"},
+			{ID: "notebook-deleting", Status: "DELETING"},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(ModelArtsNotebook), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		require.Len(t, logger.lines, 3)
+		l := logger.lines[1]
+		assert.Equal(t, level.DebugValue(), l["level"])
+		assert.Equal(t, string(ModelArtsNotebook), l["resource"])
+		assert.Equal(t, "notebook-deleting", l["id"])
+		assert.Equal(t, "unsupported status DELETING", l["reason"])
+	})
+
+	t.Run("VerboseTypeFilter", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			r      = mock.NewHuaweicloudReader(ctrl)
+			p      = newTestProvider(t, r)
+			logger = &capturingLogger{}
+		)
+		defer ctrl.Finish()
+
+		p.logger = logger
+		p.options.VerboseReaderErrors = true
+		p.options.TypeFilters = map[string]TypeFilter{
+			string(ModelArtsNotebook): {Name: regexp.MustCompile("^prod-")},
+		}
+
+		_, err := p.FixResource(string(ModelArtsNotebook), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("notebook-1"),
+			"name": cty.StringVal("dev-notebook"),
+		}))
+		assert.True(t, errors.Is(err, errcode.ErrProviderResourceDoNotMatchName))
+
+		require.Len(t, logger.lines, 1)
+		assert.Equal(t, level.DebugValue(), logger.lines[0]["level"])
+		assert.Equal(t, "notebook-1", logger.lines[0]["id"])
+		assert.Equal(t, err.Error(), logger.lines[0]["reason"])
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			r      = mock.NewHuaweicloudReader(ctrl)
+			p      = newTestProvider(t, r)
+			ctx    = context.Background()
+			logger = &capturingLogger{}
+		)
+		defer ctrl.Finish()
+
+		p.logger = logger
+
+		r.EXPECT().ListModelArtsNotebooks(ctx, reader.Page{}).Return([]reader.ModelArtsNotebook{
+			{ID: "notebook-running", Status: "RUNNING"},
+			{ID: "notebook-deleting", Status: "DELETING"},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(ModelArtsNotebook), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		// Only the start and the end of the reading
+		require.Len(t, logger.lines, 2)
+		for _, l := range logger.lines {
+			assert.NotContains(t, l, "reason")
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package huaweicloud
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// fixOBSBucketWebsite cleans the 'website' block of the bucket v so it
+// maps to the schema: the block is omitted if the website hosting is
+// not configured, the 'redirect_all_requests_to' conflicts with the
+// other attributes and the empty values of the 'routing_rules' are removed
+func fixOBSBucketWebsite(v cty.Value) (cty.Value, error) {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("website") {
+		return v, nil
+	}
+
+	website := v.GetAttr("website")
+	if website.IsNull() || !website.IsKnown() || website.LengthInt() == 0 {
+		return v, nil
+	}
+
+	ety := website.Type().ElementType()
+	nws := make([]cty.Value, 0, website.LengthInt())
+	for it := website.ElementIterator(); it.Next(); {
+		_, w := it.Element()
+		attrs := w.AsValueMap()
+
+		if isEmptyString(attrs["redirect_all_requests_to"]) {
+			attrs["redirect_all_requests_to"] = cty.NullVal(cty.String)
+
+			rr, err := normalizeOBSRoutingRules(attrs["routing_rules"])
+			if err != nil {
+				return v, err
+			}
+			attrs["routing_rules"] = rr
+		} else {
+			for _, k := range []string{"index_document", "error_document", "routing_rules"} {
+				attrs[k] = cty.NullVal(cty.String)
+			}
+		}
+
+		empty := true
+		for _, a := range attrs {
+			if !isEmptyString(a) {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			continue
+		}
+
+		for k, a := range attrs {
+			if isEmptyString(a) {
+				attrs[k] = cty.NullVal(cty.String)
+			}
+		}
+
+		nws = append(nws, cty.ObjectVal(attrs))
+	}
+
+	nwebsite := cty.ListValEmpty(ety)
+	if len(nws) != 0 {
+		nwebsite = cty.ListVal(nws)
+	}
+
+	vm := v.AsValueMap()
+	vm["website"] = nwebsite
+
+	return cty.ObjectVal(vm), nil
+}
+
+// fixOBSBucketACL removes the inline 'acl' of the bucket v if its
+// ACL is imported as a huaweicloud_obs_bucket_acl, so they do not
+// override each other on each apply
+func fixOBSBucketACL(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") || !v.Type().HasAttribute("acl") {
+		return v
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v
+	}
+
+	p.bucketACLsMu.Lock()
+	_, ok := p.bucketACLs[id.AsString()]
+	p.bucketACLsMu.Unlock()
+	if !ok {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	vm["acl"] = cty.NullVal(cty.String)
+
+	return cty.ObjectVal(vm)
+}
+
+// obsStorageClasses are the OBS bucket storage classes
+// ordered from the warmest to the coldest one
+var obsStorageClasses = map[string]int{
+	"STANDARD":     0,
+	"WARM":         1,
+	"COLD":         2,
+	"DEEP_ARCHIVE": 3,
+}
+
+// obsStorageClassAliases are the storage classes used by the API
+// that have a different name on the 'storage_class' of the schema
+var obsStorageClassAliases = map[string]string{
+	"STANDARD_IA": "WARM",
+	"GLACIER":     "COLD",
+}
+
+// fixOBSBucketStorageClass keeps the real storage class of the bucket v
+// with the names used by the schema and removes the 'storage_info'
+// (size and number of objects) which can not be managed. The objects of
+// the cold buckets can not transition to a warmer or the same storage class,
+// so those transitions of the 'lifecycle_rule' are removed
+func fixOBSBucketStorageClass(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return v
+	}
+
+	vm := v.AsValueMap()
+
+	if si, ok := vm["storage_info"]; ok {
+		vm["storage_info"] = cty.NullVal(si.Type())
+	}
+
+	sc, ok := vm["storage_class"]
+	if !ok {
+		return cty.ObjectVal(vm)
+	}
+
+	class := "STANDARD"
+	if !isEmptyString(sc) {
+		class = strings.ToUpper(sc.AsString())
+	}
+	if a, ok := obsStorageClassAliases[class]; ok {
+		class = a
+	}
+	vm["storage_class"] = cty.StringVal(class)
+
+	if lr, ok := vm["lifecycle_rule"]; ok && obsStorageClasses[class] > obsStorageClasses["WARM"] {
+		vm["lifecycle_rule"] = pruneOBSLifecycleTransitions(lr, class)
+	}
+
+	return cty.ObjectVal(vm)
+}
+
+// pruneOBSLifecycleTransitions removes the transitions of the lifecycle rules lr
+// to a storage class that is not colder than the class of the bucket
+func pruneOBSLifecycleTransitions(lr cty.Value, class string) cty.Value {
+	if lr.IsNull() || !lr.IsKnown() || lr.LengthInt() == 0 {
+		return lr
+	}
+
+	nrules := make([]cty.Value, 0, lr.LengthInt())
+	for it := lr.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		attrs := rule.AsValueMap()
+
+		for _, k := range []string{"transition", "noncurrent_version_transition"} {
+			ts, ok := attrs[k]
+			if !ok || ts.IsNull() || !ts.IsKnown() || ts.LengthInt() == 0 {
+				continue
+			}
+
+			nts := make([]cty.Value, 0, ts.LengthInt())
+			for tit := ts.ElementIterator(); tit.Next(); {
+				_, t := tit.Element()
+				tsc := t.GetAttr("storage_class")
+				if isEmptyString(tsc) {
+					continue
+				}
+
+				tclass := strings.ToUpper(tsc.AsString())
+				if a, ok := obsStorageClassAliases[tclass]; ok {
+					tclass = a
+				}
+				if obsStorageClasses[tclass] <= obsStorageClasses[class] {
+					continue
+				}
+				nts = append(nts, t)
+			}
+
+			if len(nts) == 0 {
+				attrs[k] = cty.ListValEmpty(ts.Type().ElementType())
+			} else {
+				attrs[k] = cty.ListVal(nts)
+			}
+		}
+
+		nrules = append(nrules, cty.ObjectVal(attrs))
+	}
+
+	return cty.ListVal(nrules)
+}
+
+// normalizeOBSRoutingRules removes the empty values of the JSON
+// routing rules rr, if no rule is left it returns a null value
+func normalizeOBSRoutingRules(rr cty.Value) (cty.Value, error) {
+	if isEmptyString(rr) {
+		return cty.NullVal(cty.String), nil
+	}
+
+	var rules []interface{}
+	if err := json.Unmarshal([]byte(rr.AsString()), &rules); err != nil {
+		return rr, errors.Wrap(err, "invalid OBS website routing rules")
+	}
+
+	clean := make([]interface{}, 0, len(rules))
+	for _, r := range rules {
+		if c := removeEmptyJSON(r); c != nil {
+			clean = append(clean, c)
+		}
+	}
+
+	if len(clean) == 0 {
+		return cty.NullVal(cty.String), nil
+	}
+
+	b, err := json.Marshal(clean)
+	if err != nil {
+		return rr, errors.Wrap(err, "unable to marshal the OBS website routing rules")
+	}
+
+	return cty.StringVal(string(b)), nil
+}
+
+// removeEmptyJSON removes recursively the empty strings, nulls
+// and empty objects of the decoded JSON v, it returns nil
+// if nothing is left
+func removeEmptyJSON(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, e := range vv {
+			if c := removeEmptyJSON(e); c != nil {
+				vv[k] = c
+			} else {
+				delete(vv, k)
+			}
+		}
+		if len(vv) == 0 {
+			return nil
+		}
+		return vv
+	case string:
+		if vv == "" {
+			return nil
+		}
+	}
+	return v
+}
+
+// isEmptyString checks if v is a null, unknown or empty string
+func isEmptyString(v cty.Value) bool {
+	return v.IsNull() || !v.IsKnown() || (v.Type() == cty.String && v.AsString() == "")
+}
@@ -0,0 +1,154 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var obsWebsiteType = cty.Object(map[string]cty.Type{
+	"index_document":           cty.String,
+	"error_document":           cty.String,
+	"redirect_all_requests_to": cty.String,
+	"routing_rules":            cty.String,
+})
+
+func obsBucket(website ...cty.Value) cty.Value {
+	ws := cty.ListValEmpty(obsWebsiteType)
+	if len(website) != 0 {
+		ws = cty.ListVal(website)
+	}
+	return cty.ObjectVal(map[string]cty.Value{
+		"bucket":  cty.StringVal("bucket"),
+		"website": ws,
+	})
+}
+
+func TestFixOBSBucketWebsite(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	t.Run("WebsiteEnabled", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsBucket(cty.ObjectVal(map[string]cty.Value{
+			"index_document":           cty.StringVal("index.html"),
+			"error_document":           cty.StringVal(""),
+			"redirect_all_requests_to": cty.StringVal(""),
+			"routing_rules":            cty.StringVal(`[{"Condition":{"KeyPrefixEquals":"docs/","HttpErrorCodeReturnedEquals":""},"Redirect":{"ReplaceKeyPrefixWith":"documents/","Protocol":"","HostName":""}}]`),
+		})))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsBucket(cty.ObjectVal(map[string]cty.Value{
+			"index_document":           cty.StringVal("index.html"),
+			"error_document":           cty.NullVal(cty.String),
+			"redirect_all_requests_to": cty.NullVal(cty.String),
+			"routing_rules":            cty.StringVal(`[{"Condition":{"KeyPrefixEquals":"docs/"},"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`),
+		})), v)
+	})
+
+	t.Run("WebsiteRedirectAll", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsBucket(cty.ObjectVal(map[string]cty.Value{
+			"index_document":           cty.StringVal(""),
+			"error_document":           cty.StringVal(""),
+			"redirect_all_requests_to": cty.StringVal("https://example.com"),
+			"routing_rules":            cty.StringVal("[]"),
+		})))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsBucket(cty.ObjectVal(map[string]cty.Value{
+			"index_document":           cty.NullVal(cty.String),
+			"error_document":           cty.NullVal(cty.String),
+			"redirect_all_requests_to": cty.StringVal("https://example.com"),
+			"routing_rules":            cty.NullVal(cty.String),
+		})), v)
+	})
+
+	t.Run("Plain", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsBucket(cty.ObjectVal(map[string]cty.Value{
+			"index_document":           cty.StringVal(""),
+			"error_document":           cty.StringVal(""),
+			"redirect_all_requests_to": cty.StringVal(""),
+			"routing_rules":            cty.StringVal(""),
+		})))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsBucket(), v)
+	})
+}
+
+var (
+	obsStorageInfoType = cty.List(cty.Object(map[string]cty.Type{
+		"size":          cty.Number,
+		"object_number": cty.Number,
+	}))
+	obsTransitionType = cty.Object(map[string]cty.Type{
+		"days":          cty.Number,
+		"storage_class": cty.String,
+	})
+)
+
+func obsTransition(days int64, class string) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"days":          cty.NumberIntVal(days),
+		"storage_class": cty.StringVal(class),
+	})
+}
+
+func obsStorageBucket(class, storageInfo cty.Value, transitions ...cty.Value) cty.Value {
+	ts := cty.ListValEmpty(obsTransitionType)
+	if len(transitions) != 0 {
+		ts = cty.ListVal(transitions)
+	}
+	return cty.ObjectVal(map[string]cty.Value{
+		"bucket":        cty.StringVal("bucket"),
+		"storage_class": class,
+		"storage_info":  storageInfo,
+		"lifecycle_rule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"name":       cty.StringVal("archive"),
+			"transition": ts,
+		})}),
+	})
+}
+
+func TestFixOBSBucketStorageClass(t *testing.T) {
+	p := newTestProvider(t, nil)
+	storageInfo := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"size":          cty.NumberIntVal(1024),
+		"object_number": cty.NumberIntVal(3),
+	})})
+
+	t.Run("Standard", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsStorageBucket(cty.StringVal("STANDARD"), storageInfo, obsTransition(30, "WARM"), obsTransition(90, "COLD")))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsStorageBucket(cty.StringVal("STANDARD"), cty.NullVal(obsStorageInfoType), obsTransition(30, "WARM"), obsTransition(90, "COLD")), v)
+	})
+
+	t.Run("Warm", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsStorageBucket(cty.StringVal("STANDARD_IA"), storageInfo, obsTransition(90, "COLD")))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsStorageBucket(cty.StringVal("WARM"), cty.NullVal(obsStorageInfoType), obsTransition(90, "COLD")), v)
+	})
+
+	t.Run("Cold", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsStorageBucket(cty.StringVal("GLACIER"), storageInfo, obsTransition(30, "WARM"), obsTransition(60, "COLD"), obsTransition(180, "DEEP_ARCHIVE")))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsStorageBucket(cty.StringVal("COLD"), cty.NullVal(obsStorageInfoType), obsTransition(180, "DEEP_ARCHIVE")), v)
+	})
+
+	t.Run("ColdWithoutTransitionsLeft", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsStorageBucket(cty.StringVal("COLD"), storageInfo, obsTransition(30, "WARM")))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsStorageBucket(cty.StringVal("COLD"), cty.NullVal(obsStorageInfoType)), v)
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		v, err := p.FixResource(string(OBSBucket), obsStorageBucket(cty.StringVal(""), cty.NullVal(obsStorageInfoType)))
+		require.NoError(t, err)
+
+		assert.Equal(t, obsStorageBucket(cty.StringVal("STANDARD"), cty.NullVal(obsStorageInfoType)), v)
+	})
+}
@@ -0,0 +1,279 @@
+package huaweicloud
+
+import (
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TagsMissingAny is the Options.TagsMissing value used
+// to import only the resources that have no tags at all
+const TagsMissingAny = "*"
+
+// FlavorValidation is the action done when an ECS or RDS
+// instance uses a flavor that is no longer available
+type FlavorValidation string
+
+const (
+	// FlavorValidationWarn only logs a warning
+	FlavorValidationWarn FlavorValidation = "warn"
+
+	// FlavorValidationSubstitute replaces the flavor of the ECS
+	// instance with the nearest available one, the RDS ones
+	// are only warned
+	FlavorValidationSubstitute FlavorValidation = "substitute"
+)
+
+// Options are the optional configurations of the Huawei Cloud Provider
+type Options struct {
+	// TagsMissing, if defined, imports only the resources that
+	// are missing the tag key, or that have no tags at all
+	// if the value is TagsMissingAny
+	TagsMissing string
+
+	// FlavorValidation, if defined, validates the flavor of each ECS
+	// and RDS instance against the available flavors of the region
+	FlavorValidation FlavorValidation
+
+	// Proxy is the URL of the HTTP(S) proxy used for all the
+	// API calls, if not defined the HTTPS_PROXY, HTTP_PROXY
+	// and NO_PROXY environment variables are used
+	Proxy string
+
+	// Insecure disables the TLS verification, it should only
+	// be used for endpoints with self-signed certificates (ex: HCS)
+	Insecure bool
+
+	// Catalog, if defined, is the custom catalog of the regions
+	// used to resolve the endpoints of the services instead of the
+	// Huawei Cloud one (ex: Huawei Cloud Stack), the region of the
+	// Provider has to be on it
+	Catalog *Catalog
+
+	// DRSIncludeFinished imports also the DRS jobs that
+	// are already completed or deleted
+	DRSIncludeFinished bool
+
+	// IncludeDefaults imports also the resources created by
+	// default by Huawei Cloud (ex: the 'default' security group)
+	// which are skipped otherwise
+	IncludeDefaults bool
+
+	// PluginCacheDir, if defined, is the Terraform plugin cache
+	// directory (TF_PLUGIN_CACHE_DIR) used by the embedded provider
+	// so the repeated runs (ex: on CI) can share it
+	PluginCacheDir string
+
+	// EnterpriseProjectID, if defined, imports only the resources of
+	// the enterprise project, the types that do not support it can not
+	// be imported (ex: they are not on the Provider.ResourceTypes)
+	EnterpriseProjectID string
+
+	// AllEnterpriseProjects imports the resources of each one of the
+	// enterprise projects the caller can see, the resources have the
+	// 'enterprise_project_id' set to know where they come from. As with
+	// the EnterpriseProjectID only the types supporting it are imported
+	AllEnterpriseProjects bool
+
+	// BatchConcurrency is the number of resource types read at
+	// the same time by the BatchProvider.ResourcesBatch, if
+	// not defined they are read one by one
+	BatchConcurrency int
+
+	// ParallelTypesOnly reads the resource types shared through the
+	// cache (ex: the VPCs) one by one before the rest with the
+	// BatchProvider.ResourcesBatch, so only the types referencing
+	// them are read concurrently and each cache is populated once.
+	// The pages of a type are always read one after the other
+	ParallelTypesOnly bool
+
+	// RMS reads the resource types supported by the Resource Management
+	// Service (the rmsResourceTypes, ex: the VPCs) from its inventory with
+	// the BatchProvider.ResourcesBatch, which is faster than their service
+	// APIs. The rest of the types, or all of them if the RMS is not
+	// enabled, are read from the service APIs
+	RMS bool
+
+	// ResourceQL, if defined, is an advanced query of the RMS selecting
+	// the 'id', 'provider', 'type', 'region_id' and 'ep_id' of the
+	// resources to import with the BatchProvider.ResourcesBatch (ex:
+	// "SELECT id, provider, type, region_id, ep_id FROM resources"),
+	// no type is read from its service API. Only the types built from the
+	// RMS inventory of the region are imported, the rest are skipped
+	ResourceQL string
+
+	// GroupByTag, if defined, is the tag key used to group the
+	// resources on the HCL, on one file per value of the tag.
+	// The resources without the tag go to the GroupByTagDefault
+	GroupByTag string
+
+	// Timings logs the duration of the reader of each resource
+	// type at the end of the BatchProvider.ResourcesBatch,
+	// to know which ones slow down the import
+	Timings bool
+
+	// ChargingMode, if defined, imports only the billable resources
+	// (ECS instances, RDS instances, EVS volumes and EIPs) with the
+	// charging mode, the rest of the resources are not affected
+	ChargingMode ChargingMode
+
+	// InlineDataDisks imports the data disks attached to an ECS
+	// instance as the 'data_disks' of it instead of as EVS volumes,
+	// the disks shared by more than one instance are not inlined
+	InlineDataDisks bool
+
+	// InlineEIPs imports the EIP bound to an ECS instance as the
+	// 'eip_id' of it instead of as an EIP association, the
+	// instances with more than one EIP are not inlined
+	InlineEIPs bool
+
+	// RDSReadReplicas imports the read replicas of the RDS instances
+	// as huaweicloud_rds_read_replica_instance, they are never
+	// imported as huaweicloud_rds_instance like their primary instances
+	RDSReadReplicas bool
+
+	// PinDHCPIPs keeps the 'fixed_ip_v4' of the NICs of the ECS
+	// instances on the subnets with DHCP enabled, by default only
+	// the IPs of the subnets without DHCP are kept as they can only
+	// be assigned manually, the rest are left to the DHCP
+	PinDHCPIPs bool
+
+	// ValidateHCL validates the HCL of each resource against the
+	// schema of the embedded TF Provider, the resources that do
+	// not satisfy it are reported at the end of the import
+	ValidateHCL bool
+
+	// VerboseReaderErrors logs at debug level each resource that
+	// is read but not imported with the reason of it (ex: its
+	// status or the charging mode), which are skipped silently
+	VerboseReaderErrors bool
+
+	// CreatedAfter, if defined, imports only the resources created
+	// after it, by the creation time read by the TF Provider (ex:
+	// 'created_at'). The resources without it are imported unless the
+	// ExcludeWithoutCreationTime is enabled
+	CreatedAfter time.Time
+
+	// ExcludeWithoutCreationTime skips the resources without a
+	// creation time when the CreatedAfter is defined
+	ExcludeWithoutCreationTime bool
+
+	// Statuses, if defined, imports only the resources which status,
+	// read by the TF Provider (ex: 'status'), is one of them without
+	// matching the case. The types without status are imported
+	Statuses []string
+
+	// TagTransform renames the keys of the tags of the ECS instances
+	// on the HCL and the state, the tags filters match the new keys
+	TagTransform TagTransform
+
+	// TypeFilters are the filters of each resource type, by type
+	TypeFilters map[string]TypeFilter
+}
+
+// validate checks that the values of the Options are valid
+func (o Options) validate() error {
+	switch o.FlavorValidation {
+	case "", FlavorValidationWarn, FlavorValidationSubstitute:
+	default:
+		return errors.Errorf("invalid flavor validation %q, the valid values are %q and %q", o.FlavorValidation, FlavorValidationWarn, FlavorValidationSubstitute)
+	}
+
+	switch o.ChargingMode {
+	case "", ChargingModePrePaid, ChargingModePostPaid, ChargingModeSpot:
+	default:
+		return errors.Errorf("invalid charging mode %q, the valid values are %q, %q and %q", o.ChargingMode, ChargingModePrePaid, ChargingModePostPaid, ChargingModeSpot)
+	}
+
+	if o.Proxy != "" {
+		if _, err := o.proxyURL(); err != nil {
+			return err
+		}
+	}
+
+	if o.Catalog != nil {
+		if err := o.Catalog.validate(); err != nil {
+			return err
+		}
+	}
+
+	if o.EnterpriseProjectID != "" && o.AllEnterpriseProjects {
+		return errors.New("the enterprise project ID can not be defined when importing all the enterprise projects")
+	}
+
+	if o.ExcludeWithoutCreationTime && o.CreatedAfter.IsZero() {
+		return errors.New("the resources without creation time can only be excluded when the created after is defined")
+	}
+
+	for _, s := range o.Statuses {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("invalid status, it can not be empty")
+		}
+	}
+
+	if o.ResourceQL != "" {
+		if err := validateResourceQL(o.ResourceQL); err != nil {
+			return err
+		}
+	}
+
+	if o.BatchConcurrency < 0 {
+		return errors.Errorf("invalid batch concurrency %d, it can not be negative", o.BatchConcurrency)
+	}
+
+	if err := o.TagTransform.validate(); err != nil {
+		return errors.Wrap(err, "invalid tag transform")
+	}
+
+	for t, tf := range o.TypeFilters {
+		if _, err := ResourceTypeString(t); err != nil {
+			return errors.Wrap(err, "invalid type filter")
+		}
+		if err := tf.validate(); err != nil {
+			return errors.Wrapf(err, "invalid type filter of %q", t)
+		}
+	}
+
+	if o.PluginCacheDir != "" {
+		if err := validatePluginCacheDir(o.PluginCacheDir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePluginCacheDir checks that the dir exists and is writable
+func validatePluginCacheDir(dir string) error {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return errors.Wrapf(err, "invalid plugin cache dir %q", dir)
+	}
+	if !fi.IsDir() {
+		return errors.Errorf("invalid plugin cache dir %q, it's not a directory", dir)
+	}
+
+	f, err := os.CreateTemp(dir, ".terracognita-")
+	if err != nil {
+		return errors.Wrapf(err, "invalid plugin cache dir %q, it's not writable", dir)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	return nil
+}
+
+// proxyURL returns the parsed Proxy
+func (o Options) proxyURL() (*url.URL, error) {
+	u, err := url.Parse(o.Proxy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid proxy %q", o.Proxy)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("invalid proxy %q, the expected format is 'http(s)://HOST:PORT'", o.Proxy)
+	}
+	return u, nil
+}
@@ -0,0 +1,112 @@
+package huaweicloud
+
+import (
+	"context"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// pagedReader is the service specific part of a resource type read
+// with the readPaged, the items T are listed by page and mapped to
+// one provider.Resource each. Only the list and the id are required
+type pagedReader[T any] struct {
+	// name is the name of the items on the errors (ex: 'ModelArts notebooks')
+	name string
+
+	// list returns a page of the items and the
+	// marker of the next one, empty on the last page
+	list func(ctx context.Context, page reader.Page) ([]T, string, error)
+
+	// get returns the item with all its details, for the APIs
+	// which only return a summary of them on the list
+	get func(ctx context.Context, item T) (T, error)
+
+	// id returns the ID of the resource of the item
+	id func(item T) string
+
+	// skip returns the reason the item is not
+	// imported (ex: its status), empty if it is
+	skip func(item T) string
+
+	// created returns the creation time of the item, so the
+	// ones created before the Options.CreatedAfter are not
+	// read (see setListedCreationTime)
+	created func(item T) string
+
+	// status returns the status of the item, so the ones
+	// without one of the Options.Statuses are not read
+	// (see setListedStatus)
+	status func(item T) string
+
+	// set sets the data of the item on its resource r
+	set func(r provider.Resource, item T) error
+}
+
+// readPaged returns the resources of the items of pr, listed
+// page by page until the last one or until the max per type
+// of the f is reached, even in the middle of a page.
+// The skipped items are logged
+func readPaged[T any](ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter, pr pagedReader[T]) ([]provider.Resource, error) {
+	return appendPaged(ctx, p, resourceType, f, make([]provider.Resource, 0), pr)
+}
+
+// appendPaged is the readPaged which appends the resources to the
+// ones already read, for the items listed per parent resource.
+// The max per type of the f applies to all the resources
+func appendPaged[T any](ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter, resources []provider.Resource, pr pagedReader[T]) ([]provider.Resource, error) {
+	var page reader.Page
+	for {
+		items, next, err := pr.list(ctx, page)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list %s", pr.name)
+		}
+
+		for _, i := range items {
+			id := pr.id(i)
+
+			if pr.skip != nil {
+				if reason := pr.skip(i); reason != "" {
+					logSkipped(p, resourceType, id, reason)
+					continue
+				}
+			}
+
+			if pr.created != nil {
+				setListedCreationTime(p, resourceType, id, pr.created(i))
+			}
+			if pr.status != nil {
+				setListedStatus(p, resourceType, id, pr.status(i))
+			}
+
+			if pr.get != nil {
+				i, err = pr.get(ctx, i)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to get %s %q", pr.name, id)
+				}
+			}
+
+			r := provider.NewResource(id, resourceType, p)
+			if pr.set != nil {
+				if err := pr.set(r, i); err != nil {
+					return nil, err
+				}
+			}
+
+			resources = append(resources, r)
+
+			if f.IsMaxPerTypeReached(len(resources)) {
+				return resources, nil
+			}
+		}
+
+		if next == "" {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,142 @@
+package huaweicloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPaged(t *testing.T) {
+	type item struct {
+		ID     string
+		Status string
+		Detail string
+	}
+
+	// The pages are indexed by their marker, with the marker of the next one
+	pages := map[string]struct {
+		items []item
+		next  string
+	}{
+		"":  {items: []item{{ID: "i-1", Status: "ok"}, {ID: "i-2", Status: "deleting"}}, next: "2"},
+		"2": {items: []item{{ID: "i-3", Status: "ok"}}, next: "3"},
+		"3": {items: []item{{ID: "i-4", Status: "ok"}}},
+	}
+
+	ids := func(rs []provider.Resource) []string {
+		out := make([]string, 0, len(rs))
+		for _, r := range rs {
+			out = append(out, r.ID())
+		}
+		return out
+	}
+
+	newReader := func(listed *[]string) pagedReader[item] {
+		return pagedReader[item]{
+			name: "items",
+			list: func(_ context.Context, page reader.Page) ([]item, string, error) {
+				*listed = append(*listed, page.Marker)
+				pg := pages[page.Marker]
+				return pg.items, pg.next, nil
+			},
+			id: func(i item) string { return i.ID },
+			skip: func(i item) string {
+				if i.Status == "deleting" {
+					return "unsupported status deleting"
+				}
+				return ""
+			},
+		}
+	}
+
+	t.Run("Pagination", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		var listed []string
+		rs, err := readPaged(context.Background(), p, string(VPC), &filter.Filter{}, newReader(&listed))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"", "2", "3"}, listed)
+		assert.Equal(t, []string{"i-1", "i-3", "i-4"}, ids(rs))
+	})
+
+	t.Run("MaxPerType", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		var listed []string
+		rs, err := readPaged(context.Background(), p, string(VPC), &filter.Filter{MaxPerType: 2}, newReader(&listed))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"", "2"}, listed)
+		assert.Equal(t, []string{"i-1", "i-3"}, ids(rs))
+	})
+
+	t.Run("AppendMaxPerType", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		f := &filter.Filter{MaxPerType: 4}
+
+		rs, err := readPaged(context.Background(), p, string(VPC), f, newReader(&[]string{}))
+		require.NoError(t, err)
+
+		var listed []string
+		rs, err = appendPaged(context.Background(), p, string(VPC), f, rs, newReader(&listed))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{""}, listed)
+		assert.Equal(t, []string{"i-1", "i-3", "i-4", "i-1"}, ids(rs))
+	})
+
+	t.Run("GetAndSet", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		var listed []string
+		pr := newReader(&listed)
+		pr.get = func(_ context.Context, i item) (item, error) {
+			i.Detail = "10.0.0.0/" + i.ID
+			return i, nil
+		}
+		pr.set = func(r provider.Resource, i item) error {
+			return r.Data().Set("cidr", i.Detail)
+		}
+
+		rs, err := readPaged(context.Background(), p, string(VPC), &filter.Filter{}, pr)
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+		assert.Equal(t, "10.0.0.0/i-1", rs[0].Data().Get("cidr"))
+	})
+
+	t.Run("ErrorList", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		pr := newReader(&[]string{})
+		pr.list = func(context.Context, reader.Page) ([]item, string, error) {
+			return nil, "", errors.New("throttled")
+		}
+
+		_, err := readPaged(context.Background(), p, string(VPC), &filter.Filter{}, pr)
+		require.Error(t, err)
+		assert.Equal(t, "unable to list items: throttled", err.Error())
+	})
+
+	t.Run("ErrorGet", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+
+		pr := newReader(&[]string{})
+		pr.get = func(_ context.Context, i item) (item, error) {
+			if i.ID == "i-3" {
+				return i, errors.New("not found")
+			}
+			return i, nil
+		}
+
+		_, err := readPaged(context.Background(), p, string(VPC), &filter.Filter{}, pr)
+		require.Error(t, err)
+		assert.Equal(t, `unable to get items "i-3": not found`, err.Error())
+	})
+}
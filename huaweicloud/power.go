@@ -0,0 +1,46 @@
+package huaweicloud
+
+import "github.com/hashicorp/go-cty/cty"
+
+// serverPowerActions are the 'power_action' that keep the
+// power state of the ECS instances by their status, the
+// instances on a transitory status (ex: 'REBOOT') have none
+var serverPowerActions = map[string]string{
+	"ACTIVE":  "ON",
+	"SHUTOFF": "OFF",
+}
+
+// serverPowerAction returns the 'power_action' of an ECS
+// instance with the status, so the stopped instances are
+// not started by 'terraform apply'
+func serverPowerAction(status string) (string, bool) {
+	a, ok := serverPowerActions[status]
+	return a, ok
+}
+
+// fixComputeInstancePowerAction sets the 'power_action' of the instance v
+// from its 'status' when it's not defined, as it's not read by the provider
+func fixComputeInstancePowerAction(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("power_action") || !v.Type().HasAttribute("status") {
+		return v
+	}
+
+	if !isEmptyString(v.GetAttr("power_action")) {
+		return v
+	}
+
+	status := v.GetAttr("status")
+	if isEmptyString(status) {
+		return v
+	}
+
+	a, ok := serverPowerAction(status.AsString())
+	if !ok {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	vm["power_action"] = cty.StringVal(a)
+
+	return cty.ObjectVal(vm)
+}
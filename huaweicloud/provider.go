@@ -2,33 +2,147 @@ package huaweicloud
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
 	"github.com/cycloidio/terracognita/cache"
+	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
+	kitlog "github.com/go-kit/kit/log"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	tfhuaweicloud "github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/pkg/errors"
 )
 
 // version of the Terraform provider.
 const version = "1.78.0"
 
+// pluginCacheDirEnv is the env variable of the
+// Terraform plugin cache directory
+const pluginCacheDirEnv = "TF_PLUGIN_CACHE_DIR"
+
 type huaweicloudProvider struct {
 	tfProvider *schema.Provider
 	tfClient   interface{}
 
 	configuration map[string]interface{}
+	options       Options
+
+	cache  cache.Cache
+	reader reader.Reader
+
+	configureOnce sync.Once
+	tfConfig      *config.Config
+	configureErr  error
+
+	// flavorSubstitutes holds the flavors that will replace
+	// the unavailable ones, indexed by ECS instance ID
+	flavorSubstitutes   map[string]reader.Flavor
+	flavorSubstitutesMu sync.Mutex
+
+	// instanceTags holds the merged tags of the tag
+	// service and the metadata, indexed by ECS instance ID
+	instanceTags   map[string]map[string]string
+	instanceTagsMu sync.Mutex
+
+	// instanceSchedulerHints holds the placement of the ECS
+	// instances with scheduler hints, indexed by ECS instance ID
+	instanceSchedulerHints   map[string]serverSchedulerHints
+	instanceSchedulerHintsMu sync.Mutex
+
+	// instanceCharging holds the billing of the
+	// ECS instances, indexed by ECS instance ID
+	instanceCharging   map[string]serverCharging
+	instanceChargingMu sync.Mutex
+
+	// dhcpSubnets are the IDs of the VPC subnets with DHCP
+	// enabled, listed with the ECS instances unless the
+	// Options.PinDHCPIPs is enabled
+	dhcpSubnets   map[string]struct{}
+	dhcpSubnetsMu sync.Mutex
+
+	// rdsInstanceConfigs holds the SSL and the non-default
+	// parameters of the RDS instances, indexed by instance ID
+	rdsInstanceConfigs   map[string]rdsInstanceConfig
+	rdsInstanceConfigsMu sync.Mutex
+
+	// imageShares holds the projects the private IMS
+	// images are shared with, indexed by image ID
+	imageShares   map[string][]string
+	imageSharesMu sync.Mutex
 
-	cache cache.Cache
+	// bucketACLs are the names of the OBS buckets
+	// which ACL is imported as a separated resource
+	bucketACLs   map[string]struct{}
+	bucketACLsMu sync.Mutex
+
+	// enterpriseProjects are the IDs of the enterprise
+	// projects to import, listed only once
+	enterpriseProjectsOnce sync.Once
+	enterpriseProjects     []string
+	enterpriseProjectsErr  error
+
+	// listedCreationTimes are the creation times of the resources
+	// listed by their API, indexed by '<resource type>.<ID>'
+	listedCreationTimes   map[string]time.Time
+	listedCreationTimesMu sync.Mutex
+
+	// listedStatuses are the statuses of the resources listed
+	// by their API, indexed by '<resource type>.<ID>'
+	listedStatuses   map[string]string
+	listedStatusesMu sync.Mutex
+
+	// readerDurations are the durations of the
+	// readers, indexed by resource type
+	readerDurations   map[string]time.Duration
+	readerDurationsMu sync.Mutex
+
+	// logger replaces the global logger if defined
+	logger kitlog.Logger
+}
+
+// ProviderOption is a functional option of the NewProvider
+// for the configurations that are not plain values
+type ProviderOption func(*huaweicloudProvider)
+
+// WithCache uses c as the cache of the resources referenced by other
+// types instead of an in-memory one, so it can be shared by many
+// providers (ex: a persistent one for long-running consumers). The keys
+// are scoped by region, project and credentials (access key and auth
+// endpoint), so the providers of the same account reuse the resources
+// read by the others. The c has to be safe for concurrent use and to
+// expire its entries, they are never deleted
+func WithCache(c cache.Cache) ProviderOption {
+	return func(p *huaweicloudProvider) {
+		p.cache = c
+	}
 }
 
 // NewProvider returns a Huawei Cloud Provider implementation.
-func NewProvider(ctx context.Context, region, projectID, accessKey, secretKey, securityToken string) (provider.Provider, error) {
+func NewProvider(ctx context.Context, region, projectID, accessKey, secretKey, securityToken string, opts Options, popts ...ProviderOption) (provider.Provider, error) {
 	log.Get().Log("func", "huaweicloud.NewProvider", "msg", "configuring TF Provider")
 
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.PluginCacheDir != "" {
+		if err := os.Setenv(pluginCacheDirEnv, opts.PluginCacheDir); err != nil {
+			return nil, errors.Wrapf(err, "unable to set %s", pluginCacheDirEnv)
+		}
+	}
+
 	tfp := tfhuaweicloud.Provider()
 
 	config := map[string]interface{}{}
@@ -47,6 +161,16 @@ func NewProvider(ctx context.Context, region, projectID, accessKey, secretKey, s
 	if securityToken != "" {
 		config["security_token"] = securityToken
 	}
+	if opts.Insecure {
+		config["insecure"] = true
+	}
+	if opts.Catalog != nil {
+		rc, ok := opts.Catalog.Regions[region]
+		if !ok {
+			return nil, errors.Errorf("the region %q is not on the catalog", region)
+		}
+		rc.setTFConfig(config)
+	}
 
 	cfg := map[string]interface{}{}
 	if region != "" {
@@ -56,16 +180,79 @@ func NewProvider(ctx context.Context, region, projectID, accessKey, secretKey, s
 		cfg["project_id"] = projectID
 	}
 
-	return &huaweicloudProvider{
+	p := &huaweicloudProvider{
 		tfProvider:    tfp,
 		tfClient:      config,
 		configuration: cfg,
+		options:       opts,
 		cache:         cache.New(),
-	}, nil
+
+		flavorSubstitutes:      make(map[string]reader.Flavor),
+		instanceTags:           make(map[string]map[string]string),
+		instanceSchedulerHints: make(map[string]serverSchedulerHints),
+		instanceCharging:       make(map[string]serverCharging),
+		dhcpSubnets:            make(map[string]struct{}),
+		bucketACLs:             make(map[string]struct{}),
+
+		rdsInstanceConfigs: make(map[string]rdsInstanceConfig),
+		imageShares:        make(map[string][]string),
+	}
+	for _, o := range popts {
+		o(p)
+	}
+	authURL, _ := config["auth_url"].(string)
+	p.cache = newSyncCache(newScopedCache(p.cache, region, projectID, accessKey, authURL))
+
+	p.reader = reader.New(p.configure)
+
+	return p, nil
 }
 
+// configure configures the TF Provider, only the first time it's called,
+// and returns the *config.Config it uses as Meta. It's done lazily so the
+// credentials are only validated when the first API call is done
+func (p *huaweicloudProvider) configure(ctx context.Context) (*config.Config, error) {
+	p.configureOnce.Do(func() {
+		log.Get().Log("func", "huaweicloud.configure", "msg", "loading TF client")
+		if diags := p.tfProvider.Configure(ctx, terraform.NewResourceConfigRaw(p.tfClient.(map[string]interface{}))); diags.HasError() {
+			p.configureErr = fmt.Errorf("could not initialize 'terraform/huaweicloud.Provider.Configure()' because: %s", diags[0].Summary)
+			return
+		}
+
+		cfg, ok := p.tfProvider.Meta().(*config.Config)
+		if !ok {
+			p.configureErr = errors.New("the TF Provider Meta is not a *config.Config")
+			return
+		}
+
+		if p.options.Proxy != "" {
+			// It's already validated on NewProvider
+			proxy, _ := p.options.proxyURL()
+			setProxy(cfg, proxy)
+		}
+
+		p.tfConfig = cfg
+	})
+
+	return p.tfConfig, p.configureErr
+}
+
+// ResourceTypes returns the types that can be imported, which
+// are only the ones supporting it if the import is scoped by
+// enterprise project
 func (p *huaweicloudProvider) ResourceTypes() []string {
-	return ResourceTypeStrings()
+	types := ResourceTypeStrings()
+	if !p.options.isEnterpriseProjectScoped() {
+		return types
+	}
+
+	scoped := make([]string, 0, len(enterpriseProjectResourceTypes))
+	for _, t := range types {
+		if validateEnterpriseProjectType(p.options, ResourceType(t)) == nil {
+			scoped = append(scoped, t)
+		}
+	}
+	return scoped
 }
 
 func (p *huaweicloudProvider) Resources(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
@@ -73,17 +260,40 @@ func (p *huaweicloudProvider) Resources(ctx context.Context, t string, f *filter
 	if err != nil {
 		return nil, err
 	}
+	if err := validateEnterpriseProjectType(p.options, rt); err != nil {
+		return nil, err
+	}
 
 	rfn, ok := resources[rt]
 	if !ok {
 		return nil, errors.Errorf("the resource %q is not implemented", t)
 	}
 
+	logger := readerLogger(p, t)
+	logger.Log("func", "huaweicloud.Resources", "msg", "reading the resources")
+
+	start := time.Now()
 	res, err := rfn(ctx, p, t, f)
+	duration := time.Since(start)
+	p.recordReaderDuration(t, duration)
 	if err != nil {
+		// Services that are not enabled or not allowed for
+		// the credentials are skipped with a custom error
+		// so the rest of the import continues
+		if isSkippableAPIError(err) {
+			return nil, fmt.Errorf("%w: %v", errcode.ErrProviderAPI, err)
+		}
 		return nil, errors.Wrapf(err, "error while reading from resource %q", t)
 	}
 
+	res = filterCreatedAfter(p, t, res)
+	res = filterStatuses(p, t, res)
+	res = truncateMaxPerType(f, res)
+	if tf, ok := p.options.TypeFilters[t]; ok {
+		res = tf.truncate(res)
+	}
+	logger.Log("func", "huaweicloud.Resources", "msg", "resources read", "count", len(res), "duration", duration.String())
+
 	return res, nil
 }
 
@@ -110,9 +320,24 @@ func (p *huaweicloudProvider) TagKey() string {
 	return "tags"
 }
 
+// TagKeyForResource returns the attribute with the tags of the
+// resource type t, which is the TagKey when the TF schema of t
+// has it as a map, or "" if the resources of t have no tags
+func (p *huaweicloudProvider) TagKeyForResource(t string) string {
+	res, ok := p.tfProvider.ResourcesMap[t]
+	if !ok {
+		return ""
+	}
+
+	if s, ok := res.Schema[p.TagKey()]; ok && s.Type == schema.TypeMap {
+		return p.TagKey()
+	}
+	return ""
+}
+
 func (p *huaweicloudProvider) HasResourceType(t string) bool {
-	_, err := ResourceTypeString(t)
-	return err == nil
+	rt, err := ResourceTypeString(t)
+	return err == nil && validateEnterpriseProjectType(p.options, rt) == nil
 }
 
 func (p *huaweicloudProvider) Source() string {
@@ -128,9 +353,112 @@ func (p *huaweicloudProvider) Configuration() map[string]interface{} {
 }
 
 func (p *huaweicloudProvider) FixResource(t string, v cty.Value) (cty.Value, error) {
+	if err := matchCreatedAfter(p, t, v); err != nil {
+		logSkipped(p, t, valueID(v), err.Error())
+		return v, err
+	}
+
+	if err := matchStatuses(p, t, v); err != nil {
+		logSkipped(p, t, valueID(v), err.Error())
+		return v, err
+	}
+
+	v = fixResourceTags(p, t, v)
+
+	var err error
+	if ResourceType(t) == ComputeInstance {
+		v, err = fixComputeInstanceTags(p, v)
+		if err != nil {
+			return v, errors.Wrapf(err, "failed to fix resources")
+		}
+		v = fixComputeInstanceTagTransform(p, v)
+	}
+
+	// The TypeFilter matches the tags once they are
+	// normalized, merged and transformed, as written
+	if tf, ok := p.options.TypeFilters[t]; ok {
+		if err := tf.match(v); err != nil {
+			logSkipped(p, t, valueID(v), err.Error())
+			return v, err
+		}
+	}
+
+	switch ResourceType(t) {
+	case ComputeInstance:
+		v, err = fixComputeInstanceFlavor(p, v)
+		if err != nil {
+			return v, errors.Wrapf(err, "failed to fix resources")
+		}
+		v = fixComputeInstancePowerAction(v)
+		v = fixComputeInstanceSchedulerHints(p, v)
+		v = fixComputeInstanceCharging(p, v)
+		v = fixComputeInstanceBilling(v)
+		v = fixComputeInstanceFixedIPs(p, v)
+	case OBSBucket:
+		v, err = fixOBSBucketWebsite(v)
+		if err != nil {
+			return v, errors.Wrapf(err, "failed to fix resources")
+		}
+		v = fixOBSBucketStorageClass(v)
+		v = fixOBSBucketACL(p, v)
+	case IdentityRole:
+		v, err = fixIdentityRolePolicy(v)
+		if err != nil {
+			return v, errors.Wrapf(err, "failed to fix resources")
+		}
+	case ELBCertificate:
+		v = fixELBCertificatePrivateKey(v)
+	case RDSInstance:
+		v = fixRDSInstanceConfig(p, v)
+	case RDSAccount:
+		v = fixRDSAccountPassword(v)
+	case VPCSubnet:
+		v = fixVPCSubnetDHCPOptions(v)
+	case EIP:
+		v = fixEIPBandwidth(v)
+	}
 	return v, nil
 }
 
+// FilterByTags filters out the resources that have tags when
+// the Options.TagsMissing is defined. It's only called for the
+// resources with tags, so the untagged ones are always imported
 func (p *huaweicloudProvider) FilterByTags(tags interface{}) error {
+	if p.options.TagsMissing == "" {
+		return nil
+	}
+
+	ts, ok := tags.(map[string]interface{})
+	if !ok || len(ts) == 0 {
+		return nil
+	}
+
+	if p.options.TagsMissing == TagsMissingAny {
+		return errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag)
+	}
+
+	if _, ok := ts[p.options.TagsMissing]; ok {
+		return errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag)
+	}
+
 	return nil
 }
+
+// isSkippableAPIError checks if the err is an error
+// returned by the APIs that should not fail the import
+func isSkippableAPIError(err error) bool {
+	var (
+		e403 golangsdk.ErrDefault403
+		e404 golangsdk.ErrDefault404
+	)
+	if errors.As(err, &e403) || errors.As(err, &e404) {
+		return true
+	}
+
+	var eobs obs.ObsError
+	if errors.As(err, &eobs) {
+		return eobs.StatusCode == http.StatusForbidden || eobs.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
@@ -2,12 +2,27 @@ package huaweicloud
 
 import (
 	"context"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/cycloidio/terracognita/cache"
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewProvider(t *testing.T) {
 	ctx := context.Background()
-	p, err := NewProvider(ctx, "cn-north-1", "123456", "access", "secret", "")
+	p, err := NewProvider(ctx, "cn-north-1", "123456", "access", "secret", "", Options{})
 	if err != nil {
 		t.Fatalf("unexpected error creating provider: %v", err)
 	}
@@ -28,3 +43,157 @@ func TestNewProvider(t *testing.T) {
 		t.Fatalf("expected ResourceTypes to be populated")
 	}
 }
+
+func TestFilterByTags(t *testing.T) {
+	tags := map[string]interface{}{"owner": "cycloid"}
+
+	t.Run("Disabled", func(t *testing.T) {
+		p := &huaweicloudProvider{}
+		assert.NoError(t, p.FilterByTags(tags))
+	})
+	t.Run("TagsMissingAny", func(t *testing.T) {
+		p := &huaweicloudProvider{options: Options{TagsMissing: TagsMissingAny}}
+		assert.True(t, errors.Is(p.FilterByTags(tags), errcode.ErrProviderResourceDoNotMatchTag))
+		assert.NoError(t, p.FilterByTags(map[string]interface{}{}))
+	})
+	t.Run("TagsMissingKey", func(t *testing.T) {
+		p := &huaweicloudProvider{options: Options{TagsMissing: "owner"}}
+		assert.True(t, errors.Is(p.FilterByTags(tags), errcode.ErrProviderResourceDoNotMatchTag))
+		assert.NoError(t, p.FilterByTags(map[string]interface{}{"env": "prod"}))
+	})
+}
+
+func TestNewProviderInvalidOptions(t *testing.T) {
+	_, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{FlavorValidation: "fail"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{EnterpriseProjectID: "eps-1", AllEnterpriseProjects: true})
+	assert.Error(t, err)
+
+	_, err = NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{ChargingMode: "reserved"})
+	assert.Error(t, err)
+}
+
+func TestNewProviderWithCache(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		ctx  = context.Background()
+		c    = cache.New()
+	)
+	defer ctrl.Finish()
+
+	newProvider := func(t *testing.T, region, accessKey string) *huaweicloudProvider {
+		p, err := NewProvider(ctx, region, "123456", accessKey, "secret", "", Options{}, WithCache(c))
+		require.NoError(t, err)
+
+		hp := p.(*huaweicloudProvider)
+		hp.reader = r
+		return hp
+	}
+
+	// The DeH hosts are listed once by the providers with
+	// the same credentials and once by each other account
+	r.EXPECT().ListDeHHosts(ctx, reader.Page{}).Return([]reader.DeHHost{{ID: "deh-1"}}, "", nil)
+	r.EXPECT().ListDeHHosts(ctx, reader.Page{}).Return([]reader.DeHHost{{ID: "deh-2"}}, "", nil)
+
+	p1 := newProvider(t, "cn-north-1", "access")
+	rs, err := p1.Resources(ctx, string(DeHHost), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	cached, err := c.Get(p1.cache.(*syncCache).cache.(*scopedCache).scope + string(DeHHost))
+	require.NoError(t, err)
+	assert.Equal(t, rs, cached)
+
+	p2 := newProvider(t, "cn-north-1", "access")
+	assert.Equal(t, p1.cache.(*syncCache).cache.(*scopedCache).scope, p2.cache.(*syncCache).cache.(*scopedCache).scope)
+	assert.NotContains(t, p2.cache.(*syncCache).cache.(*scopedCache).scope, "access")
+
+	rs, err = p2.Resources(ctx, string(DeHHost), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "deh-1", rs[0].ID())
+
+	p3 := newProvider(t, "cn-north-1", "other-access")
+	rs, err = p3.Resources(ctx, string(DeHHost), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "deh-2", rs[0].ID())
+	assert.Same(t, p3, rs[0].Provider())
+
+	t.Run("Scope", func(t *testing.T) {
+		scope := func(region, accessKey, authURL string) string {
+			return newScopedCache(c, region, "123456", accessKey, authURL).(*scopedCache).scope
+		}
+
+		assert.Equal(t, scope("cn-north-1", "access", ""), scope("cn-north-1", "access", ""))
+		assert.NotEqual(t, scope("cn-north-1", "access", ""), scope("cn-south-1", "access", ""))
+		assert.NotEqual(t, scope("cn-north-1", "access", ""), scope("cn-north-1", "access", "https://iam.hcs.example.com/v3"))
+	})
+}
+
+func TestNewProviderTransport(t *testing.T) {
+	t.Run("Insecure", func(t *testing.T) {
+		p, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{Insecure: true})
+		require.NoError(t, err)
+		assert.Equal(t, true, p.TFClient().(map[string]interface{})["insecure"])
+	})
+	t.Run("SecureByDefault", func(t *testing.T) {
+		p, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{})
+		require.NoError(t, err)
+		assert.NotContains(t, p.TFClient().(map[string]interface{}), "insecure")
+	})
+	t.Run("InvalidProxy", func(t *testing.T) {
+		_, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{Proxy: "proxy:3128"})
+		assert.Error(t, err)
+	})
+}
+
+func TestSetProxy(t *testing.T) {
+	var (
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+		cfg       = &config.Config{
+			HwClient: &golangsdk.ProviderClient{
+				HTTPClient: http.Client{Transport: &config.LogRoundTripper{Rt: transport}},
+			},
+		}
+		opts = Options{Proxy: "http://proxy.example.com:3128"}
+	)
+
+	proxy, err := opts.proxyURL()
+	require.NoError(t, err)
+
+	setProxy(cfg, proxy)
+
+	req, err := http.NewRequest(http.MethodGet, "https://ecs.cn-north-1.myhuaweicloud.com", nil)
+	require.NoError(t, err)
+
+	u, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", u.String())
+}
+
+func TestNewProviderPluginCacheDir(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		t.Setenv(pluginCacheDirEnv, "")
+		dir := t.TempDir()
+
+		p, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{PluginCacheDir: dir})
+		require.NoError(t, err)
+
+		assert.Equal(t, dir, p.(*huaweicloudProvider).options.PluginCacheDir)
+		assert.Equal(t, dir, os.Getenv(pluginCacheDirEnv))
+	})
+	t.Run("ErrorNotExists", func(t *testing.T) {
+		_, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{PluginCacheDir: filepath.Join(t.TempDir(), "missing")})
+		assert.Error(t, err)
+	})
+	t.Run("ErrorNotDir", func(t *testing.T) {
+		f := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(f, []byte{}, 0644))
+
+		_, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{PluginCacheDir: f})
+		assert.Error(t, err)
+	})
+}
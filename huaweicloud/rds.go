@@ -0,0 +1,186 @@
+package huaweicloud
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// rdsInstanceConfig is the configuration of an RDS instance
+// that the TF Provider does not read when importing it
+type rdsInstanceConfig struct {
+	SSL bool
+
+	// Parameters are the values of the parameters that are not
+	// the default of the datastore of the instance, by name
+	Parameters map[string]string
+}
+
+// rdsDefaultParameters lists the default parameters of the
+// datastores of the RDS instances, only the first time they
+// are needed and once per default parameter template
+type rdsDefaultParameters struct {
+	p *huaweicloudProvider
+
+	configurations []reader.RDSConfiguration
+	parameters     map[string]map[string]string
+}
+
+// get returns the default parameters of the datastore ds, nil
+// if it has no default template as they can not be compared
+func (d *rdsDefaultParameters) get(ctx context.Context, ds reader.RDSDatastore) (map[string]string, error) {
+	if d.configurations == nil {
+		cs, err := d.p.reader.ListRDSConfigurations(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list RDS parameter templates")
+		}
+		d.configurations = cs
+		d.parameters = make(map[string]map[string]string)
+	}
+
+	var id string
+	for _, c := range d.configurations {
+		if !c.UserDefined && strings.EqualFold(c.DatastoreName, ds.Type) && c.DatastoreVersionName == ds.Version {
+			id = c.ID
+			break
+		}
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	if params, ok := d.parameters[id]; ok {
+		return params, nil
+	}
+
+	ps, err := d.p.reader.ListRDSConfigurationParameters(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list the parameters of the RDS parameter template %q", id)
+	}
+
+	params := make(map[string]string, len(ps))
+	for _, prm := range ps {
+		params[prm.Name] = prm.Value
+	}
+	d.parameters[id] = params
+
+	return params, nil
+}
+
+// registerRDSInstanceConfig registers the SSL and the parameters of the
+// RDS instance i that are not the default of its datastore, so they are
+// set on the instance by the fixRDSInstanceConfig
+func registerRDSInstanceConfig(ctx context.Context, p *huaweicloudProvider, defaults *rdsDefaultParameters, i reader.RDSInstance) error {
+	ps, err := p.reader.ListRDSInstanceParameters(ctx, i.ID)
+	if err != nil {
+		return errors.Wrapf(err, "unable to list the parameters of the RDS instance %q", i.ID)
+	}
+
+	cfg := rdsInstanceConfig{
+		SSL:        i.EnableSSL,
+		Parameters: make(map[string]string),
+	}
+
+	if len(ps) != 0 {
+		dps, err := defaults.get(ctx, i.Datastore)
+		if err != nil {
+			return err
+		}
+
+		// Without the default values of the datastore the
+		// parameters can not be compared, so none is set
+		for _, prm := range ps {
+			if dps == nil {
+				break
+			}
+			if prm.Readonly {
+				continue
+			}
+			if dv, ok := dps[prm.Name]; ok && dv == prm.Value {
+				continue
+			}
+			cfg.Parameters[prm.Name] = prm.Value
+		}
+	}
+
+	p.rdsInstanceConfigsMu.Lock()
+	defer p.rdsInstanceConfigsMu.Unlock()
+	p.rdsInstanceConfigs[i.ID] = cfg
+
+	return nil
+}
+
+// fixRDSInstanceConfig sets the 'ssl_enable' and the 'parameters' of the
+// RDS instance v from its registered rdsInstanceConfig, as the TF Provider
+// only reads the parameters that are already on the state. The instances
+// using the default parameters have no 'parameters', and the ones without
+// SSL have no 'ssl_enable'
+func fixRDSInstanceConfig(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") {
+		return v
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v
+	}
+
+	p.rdsInstanceConfigsMu.Lock()
+	cfg, ok := p.rdsInstanceConfigs[id.AsString()]
+	p.rdsInstanceConfigsMu.Unlock()
+	if !ok {
+		return v
+	}
+
+	vm := v.AsValueMap()
+
+	if v.Type().HasAttribute("ssl_enable") {
+		if cfg.SSL {
+			vm["ssl_enable"] = cty.True
+		} else {
+			vm["ssl_enable"] = cty.NullVal(cty.Bool)
+		}
+	}
+
+	if v.Type().HasAttribute("parameters") {
+		pt := v.Type().AttributeType("parameters")
+		if len(cfg.Parameters) == 0 || !pt.IsSetType() {
+			vm["parameters"] = cty.NullVal(pt)
+		} else {
+			names := make([]string, 0, len(cfg.Parameters))
+			for n := range cfg.Parameters {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+
+			params := make([]cty.Value, 0, len(names))
+			for _, n := range names {
+				params = append(params, cty.ObjectVal(map[string]cty.Value{
+					"name":  cty.StringVal(n),
+					"value": cty.StringVal(cfg.Parameters[n]),
+				}))
+			}
+			vm["parameters"] = cty.SetVal(params)
+		}
+	}
+
+	return cty.ObjectVal(vm)
+}
+
+// fixRDSAccountPassword removes the password of the RDS account v, as
+// it's never read it would be written empty and the 'terraform apply'
+// would try to update it, so it has to be defined on the HCL
+func fixRDSAccountPassword(v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("password") {
+		return v
+	}
+
+	vm := v.AsValueMap()
+	vm["password"] = cty.NullVal(cty.String)
+
+	return cty.ObjectVal(vm)
+}
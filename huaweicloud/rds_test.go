@@ -0,0 +1,238 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixRDSInstanceConfig(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+
+		mysql = reader.RDSDatastore{Type: "MySQL", Version: "8.0"}
+
+		parameterType = cty.Object(map[string]cty.Type{"name": cty.String, "value": cty.String})
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{
+		{ID: "rds-custom", Datastore: mysql, EnableSSL: true},
+		{ID: "rds-default", Datastore: mysql},
+		{ID: "rds-unknown", Datastore: reader.RDSDatastore{Type: "SQLServer", Version: "2019_SE"}},
+	}, "", nil)
+
+	r.EXPECT().ListRDSInstanceParameters(ctx, "rds-custom").Return([]reader.RDSParameter{
+		{Name: "max_connections", Value: "2000"},
+		{Name: "time_zone", Value: "UTC"},
+		{Name: "innodb_page_size", Value: "16384", Readonly: true},
+	}, nil)
+	r.EXPECT().ListRDSInstanceParameters(ctx, "rds-default").Return([]reader.RDSParameter{
+		{Name: "max_connections", Value: "1000"},
+		{Name: "time_zone", Value: "UTC"},
+	}, nil)
+	r.EXPECT().ListRDSInstanceParameters(ctx, "rds-unknown").Return([]reader.RDSParameter{
+		{Name: "max degree of parallelism", Value: "4"},
+	}, nil)
+
+	// The templates and the default parameters are listed only once
+	r.EXPECT().ListRDSConfigurations(ctx).Return([]reader.RDSConfiguration{
+		{ID: "cfg-custom", DatastoreName: "mysql", DatastoreVersionName: "8.0", UserDefined: true},
+		{ID: "cfg-mysql", DatastoreName: "mysql", DatastoreVersionName: "8.0"},
+	}, nil)
+	r.EXPECT().ListRDSConfigurationParameters(ctx, "cfg-mysql").Return([]reader.RDSParameter{
+		{Name: "max_connections", Value: "1000"},
+		{Name: "time_zone", Value: "UTC"},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	instance := func(id string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":         cty.StringVal(id),
+			"ssl_enable": cty.False,
+			"parameters": cty.NullVal(cty.Set(parameterType)),
+		})
+	}
+
+	v, err := p.FixResource(string(RDSInstance), instance("rds-custom"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.True, v.GetAttr("ssl_enable"))
+	assert.Equal(t, cty.SetVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("max_connections"),
+			"value": cty.StringVal("2000"),
+		}),
+	}), v.GetAttr("parameters"))
+
+	for _, id := range []string{"rds-default", "rds-unknown"} {
+		v, err = p.FixResource(string(RDSInstance), instance(id))
+		require.NoError(t, err)
+		assert.True(t, v.GetAttr("ssl_enable").IsNull(), id)
+		assert.True(t, v.GetAttr("parameters").IsNull(), id)
+	}
+}
+
+func TestRDSInstancesFlavorValidation(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+
+		mysql      = reader.RDSDatastore{Type: "MySQL", Version: "8.0"}
+		postgresql = reader.RDSDatastore{Type: "PostgreSQL", Version: "14"}
+	)
+	defer ctrl.Finish()
+
+	p.options.FlavorValidation = FlavorValidationWarn
+
+	r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{
+		{ID: "rds-mysql-1", Datastore: mysql, FlavorRef: "rds.mysql.n1.large.2"},
+		{ID: "rds-mysql-2", Datastore: mysql, FlavorRef: "rds.mysql.s1.large"},
+		{ID: "rds-pg", Datastore: postgresql, FlavorRef: "rds.pg.n1.large.2"},
+	}, "", nil)
+	r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).Times(3)
+
+	// The flavors are listed once per engine, the
+	// other instances of the engine use the cache
+	r.EXPECT().ListRDSFlavors(ctx, "mysql").Return([]reader.DBFlavor{
+		{SpecCode: "rds.mysql.n1.large.2", AZStatus: map[string]string{"cn-north-1a": "normal"}},
+		{SpecCode: "rds.mysql.s1.large", AZStatus: map[string]string{"cn-north-1a": "sellout"}},
+	}, nil)
+	r.EXPECT().ListRDSFlavors(ctx, "postgresql").Return([]reader.DBFlavor{
+		{SpecCode: "rds.pg.n1.large.2", AZStatus: map[string]string{"cn-north-1a": "normal"}},
+	}, nil)
+
+	// The unavailable flavors are only warned
+	rs, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	available, err := availableDBFlavors(ctx, p, "MySQL")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]reader.DBFlavor{
+		"rds.mysql.n1.large.2": {SpecCode: "rds.mysql.n1.large.2", AZStatus: map[string]string{"cn-north-1a": "normal"}},
+	}, available)
+
+	rs, err = p.cache.Get(dbFlavorsCacheKey("postgresql"))
+	require.NoError(t, err)
+	assert.Len(t, rs, 1)
+}
+
+func TestRDSDatabases(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{
+		{ID: "rds-mysql-1", Datastore: reader.RDSDatastore{Type: "MySQL", Version: "8.0"}},
+		{ID: "rds-pg", Datastore: reader.RDSDatastore{Type: "PostgreSQL", Version: "14"}},
+		{ID: "rds-mysql-2", Datastore: reader.RDSDatastore{Type: "MySQL", Version: "5.7"}},
+	}, "", nil)
+	r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).Times(3)
+
+	// Only the MySQL instances are listed, each one with its pages
+	r.EXPECT().ListRDSDatabases(ctx, "rds-mysql-1", reader.Page{}).Return([]reader.RDSDatabase{
+		{Name: "mysql"},
+		{Name: "orders", CharacterSet: "utf8mb4"},
+	}, "2", nil)
+	r.EXPECT().ListRDSDatabases(ctx, "rds-mysql-1", reader.Page{Marker: "2"}).Return([]reader.RDSDatabase{
+		{Name: "performance_schema"},
+		{Name: "users", CharacterSet: "utf8"},
+	}, "", nil)
+	r.EXPECT().ListRDSDatabases(ctx, "rds-mysql-2", reader.Page{}).Return([]reader.RDSDatabase{
+		{Name: "sys"},
+		{Name: "catalog", CharacterSet: "utf8mb4"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(RDSDatabase), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, e := range []struct{ ID, InstanceID, Name string }{
+		{ID: "rds-mysql-1/orders", InstanceID: "rds-mysql-1", Name: "orders"},
+		{ID: "rds-mysql-1/users", InstanceID: "rds-mysql-1", Name: "users"},
+		{ID: "rds-mysql-2/catalog", InstanceID: "rds-mysql-2", Name: "catalog"},
+	} {
+		assert.Equal(t, e.ID, rs[i].ID())
+		assert.Equal(t, e.InstanceID, rs[i].Data().Get("instance_id"))
+		assert.Equal(t, e.Name, rs[i].Data().Get("name"))
+	}
+}
+
+func TestRDSAccounts(t *testing.T) {
+	t.Run("Read", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{
+			{ID: "rds-mysql-1", Datastore: reader.RDSDatastore{Type: "MySQL", Version: "8.0"}},
+			{ID: "rds-sqlserver", Datastore: reader.RDSDatastore{Type: "SQLServer", Version: "2019_SE"}},
+			{ID: "rds-mysql-2", Datastore: reader.RDSDatastore{Type: "MySQL", Version: "8.0"}},
+		}, "", nil)
+		r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).Times(3)
+
+		r.EXPECT().ListRDSAccounts(ctx, "rds-mysql-1", reader.Page{}).Return([]reader.RDSAccount{
+			{Name: "root"},
+			{Name: "app", Hosts: []string{"10.0.0.%"}},
+			{Name: "mysql.sys"},
+		}, "", nil)
+		r.EXPECT().ListRDSAccounts(ctx, "rds-mysql-2", reader.Page{}).Return([]reader.RDSAccount{
+			{Name: "rdsAdmin"},
+			{Name: "reporting", Comment: "read only"},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(RDSAccount), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "rds-mysql-1/app", rs[0].ID())
+		assert.Equal(t, "rds-mysql-1", rs[0].Data().Get("instance_id"))
+		assert.Equal(t, "app", rs[0].Data().Get("name"))
+
+		assert.Equal(t, "rds-mysql-2/reporting", rs[1].ID())
+		assert.Equal(t, "rds-mysql-2", rs[1].Data().Get("instance_id"))
+		assert.Equal(t, "reporting", rs[1].Data().Get("name"))
+
+		for _, rr := range rs {
+			_, ok := rr.Data().GetOk("password")
+			assert.False(t, ok, rr.ID())
+		}
+	})
+
+	t.Run("FixPassword", func(t *testing.T) {
+		account := func(password cty.Value) cty.Value {
+			return cty.ObjectVal(map[string]cty.Value{
+				"id":          cty.StringVal("rds-mysql-1/app"),
+				"instance_id": cty.StringVal("rds-mysql-1"),
+				"name":        cty.StringVal("app"),
+				"password":    password,
+			})
+		}
+
+		v := fixRDSAccountPassword(account(cty.StringVal("")))
+		assert.True(t, account(cty.NullVal(cty.String)).RawEquals(v), v.GoString())
+	})
+}
@@ -0,0 +1,80 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// AntiDDoSStatus is the Anti-DDoS protection status of an EIP
+type AntiDDoSStatus struct {
+	FloatingIPID      string `json:"floating_ip_id"`
+	FloatingIPAddress string `json:"floating_ip_address"`
+	NetworkType       string `json:"network_type"`
+	Status            string `json:"status"`
+}
+
+// AADInstance is an Advanced Anti-DDoS instance
+type AADInstance struct {
+	ID     string  `json:"instance_id"`
+	Name   string  `json:"instance_name"`
+	Status int     `json:"instance_status"`
+	IPs    []AADIP `json:"ips"`
+}
+
+// AADIP is an IP protected by an AADInstance
+type AADIP struct {
+	ID     string `json:"ip_id"`
+	IP     string `json:"ip"`
+	Status int    `json:"ip_status"`
+}
+
+// AADForwardRule is a forward rule of an AADIP
+type AADForwardRule struct {
+	ID              string `json:"rule_id"`
+	ForwardProtocol string `json:"forward_protocol"`
+	ForwardPort     int    `json:"forward_port"`
+	SourcePort      int    `json:"source_port"`
+	SourceIP        string `json:"source_ip"`
+	Status          int    `json:"status"`
+}
+
+func (r *reader) ListAntiDDoSStatuses(ctx context.Context, page Page) ([]AntiDDoSStatus, string, error) {
+	var body struct {
+		DDoSStatus []AntiDDoSStatus `json:"ddosStatus"`
+	}
+
+	err := r.get(ctx, "anti-ddos", "v1/{project_id}/antiddos", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.DDoSStatus, nextOffset(page, len(body.DDoSStatus)), nil
+}
+
+func (r *reader) ListAADInstances(ctx context.Context) ([]AADInstance, error) {
+	var body struct {
+		Items []AADInstance `json:"items"`
+	}
+
+	err := r.get(ctx, "aad", "v1/aad/instances", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Items, nil
+}
+
+func (r *reader) ListAADForwardRules(ctx context.Context, instanceID, ip string) ([]AADForwardRule, error) {
+	var body struct {
+		Rules []AADForwardRule `json:"rules"`
+	}
+
+	path := fmt.Sprintf("v1/aad/instances/%s/%s/rules", url.PathEscape(instanceID), url.PathEscape(ip))
+	err := r.get(ctx, "aad", path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Rules, nil
+}
@@ -0,0 +1,44 @@
+package reader
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// AOMAlarmRule is a threshold alarm rule of the Application Operations
+// Management, the actions are the URNs of the SMN topics notified
+type AOMAlarmRule struct {
+	ID                      json.Number `json:"alarm_rule_id"`
+	Name                    string      `json:"alarm_rule_name"`
+	Type                    string      `json:"type"`
+	AlarmActions            []string    `json:"alarm_actions"`
+	OKActions               []string    `json:"ok_actions"`
+	InsufficientDataActions []string    `json:"insufficient_data_actions"`
+}
+
+// AOMAlarmRuleTypeDefault is the type of the
+// alarm rules created by default by the AOM
+const AOMAlarmRuleTypeDefault = "default"
+
+func (r *reader) ListAOMAlarmRules(ctx context.Context, page Page) ([]AOMAlarmRule, string, error) {
+	// The AOM paginates with the 'offset' but the
+	// next one is the 'start' of the response
+	var body struct {
+		MetaData struct {
+			Start *string `json:"start"`
+		} `json:"meta_data"`
+		Thresholds []AOMAlarmRule `json:"thresholds"`
+	}
+
+	err := r.get(ctx, "aom", "v2/{project_id}/alarm-rules", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if body.MetaData.Start != nil && len(body.Thresholds) != 0 {
+		next = *body.MetaData.Start
+	}
+
+	return body.Thresholds, next, nil
+}
@@ -0,0 +1,78 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ASGroup is an Auto Scaling group
+type ASGroup struct {
+	ID     string `json:"scaling_group_id"`
+	Name   string `json:"scaling_group_name"`
+	Status string `json:"scaling_group_status"`
+	VPCID  string `json:"vpc_id"`
+}
+
+// ASPolicy is a scaling policy of an ASGroup
+type ASPolicy struct {
+	ID      string `json:"scaling_policy_id"`
+	Name    string `json:"scaling_policy_name"`
+	GroupID string `json:"scaling_group_id"`
+	Status  string `json:"policy_status"`
+	Type    string `json:"scaling_policy_type"`
+	AlarmID string `json:"alarm_id"`
+}
+
+// ASLifecycleHook is a lifecycle hook of an ASGroup, which
+// notifies the SMN topic when the instances are added or removed
+type ASLifecycleHook struct {
+	Name                 string `json:"lifecycle_hook_name"`
+	Type                 string `json:"lifecycle_hook_type"`
+	NotificationTopicURN string `json:"notification_topic_urn"`
+}
+
+// ASPolicyTypeAlarm is the ASPolicy.Type of the
+// policies triggered by a CES alarm
+const ASPolicyTypeAlarm = "ALARM"
+
+func (r *reader) ListASGroups(ctx context.Context, page Page) ([]ASGroup, string, error) {
+	var body struct {
+		ScalingGroups []ASGroup `json:"scaling_groups"`
+	}
+
+	err := r.get(ctx, "autoscaling", "autoscaling-api/v1/{project_id}/scaling_group", startNumberQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.ScalingGroups, nextOffset(page, len(body.ScalingGroups)), nil
+}
+
+func (r *reader) ListASPolicies(ctx context.Context, groupID string, page Page) ([]ASPolicy, string, error) {
+	var body struct {
+		ScalingPolicies []ASPolicy `json:"scaling_policies"`
+	}
+
+	path := fmt.Sprintf("autoscaling-api/v1/{project_id}/scaling_policy/%s/list", url.PathEscape(groupID))
+	err := r.get(ctx, "autoscaling", path, startNumberQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.ScalingPolicies, nextOffset(page, len(body.ScalingPolicies)), nil
+}
+
+func (r *reader) ListASLifecycleHooks(ctx context.Context, groupID string) ([]ASLifecycleHook, error) {
+	var body struct {
+		LifecycleHooks []ASLifecycleHook `json:"lifecycle_hooks"`
+	}
+
+	path := fmt.Sprintf("autoscaling-api/v1/{project_id}/scaling_lifecycle_hook/%s/list", url.PathEscape(groupID))
+	err := r.get(ctx, "autoscaling", path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.LifecycleHooks, nil
+}
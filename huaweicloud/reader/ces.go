@@ -0,0 +1,52 @@
+package reader
+
+import "context"
+
+// CESAlarmRule is an alarm rule of Cloud Eye
+type CESAlarmRule struct {
+	ID                 string                 `json:"alarm_id"`
+	Name               string                 `json:"name"`
+	Namespace          string                 `json:"namespace"`
+	Type               string                 `json:"type"`
+	Enabled            bool                   `json:"enabled"`
+	Resources          []CESAlarmResource     `json:"resources"`
+	AlarmNotifications []CESAlarmNotification `json:"alarm_notifications"`
+}
+
+// CESAlarmResource is a group of dimensions
+// monitored by a CESAlarmRule
+type CESAlarmResource struct {
+	ResourceGroupID string         `json:"resource_group_id"`
+	Dimensions      []CESDimension `json:"dimensions"`
+}
+
+// CESDimension identifies a monitored resource, the Name depends
+// on the namespace (ex: 'instance_id' for 'SYS.ECS')
+type CESDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CESAlarmNotification is a notification of a CESAlarmRule,
+// the NotificationList has the SMN topics URNs
+type CESAlarmNotification struct {
+	Type             string   `json:"type"`
+	NotificationList []string `json:"notification_list"`
+}
+
+// CESAlarmTypeSystemEvent is the CESAlarmRule.Type of the
+// rules created for the system events of Huawei Cloud
+const CESAlarmTypeSystemEvent = "EVENT.SYS"
+
+func (r *reader) ListCESAlarmRules(ctx context.Context, page Page) ([]CESAlarmRule, string, error) {
+	var body struct {
+		Alarms []CESAlarmRule `json:"alarms"`
+	}
+
+	err := r.get(ctx, "cesv2", "v2/{project_id}/alarms", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Alarms, nextOffset(page, len(body.Alarms)), nil
+}
@@ -0,0 +1,86 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+)
+
+// CFWFirewall is a firewall instance of the Cloud Firewall
+type CFWFirewall struct {
+	ID             string             `json:"fw_instance_id"`
+	Name           string             `json:"name"`
+	Status         int                `json:"status"`
+	ProtectObjects []CFWProtectObject `json:"protect_objects"`
+}
+
+// CFWProtectObject is an object protected by a CFW firewall,
+// the protection rules are attached to the object
+type CFWProtectObject struct {
+	ObjectID   string `json:"object_id"`
+	ObjectName string `json:"object_name"`
+	Type       int    `json:"type"`
+}
+
+const (
+	// CFWProtectObjectTypeInternet is the type of the protected
+	// object of the Internet border of a firewall
+	CFWProtectObjectTypeInternet = 0
+
+	// cfwServiceTypeNorthSouth is the service type of the
+	// firewalls listed with their Internet border objects
+	cfwServiceTypeNorthSouth = 0
+)
+
+// InternetObjectID returns the ID of the protected object of the
+// Internet border of the firewall, which has its protection rules
+func (f CFWFirewall) InternetObjectID() string {
+	for _, o := range f.ProtectObjects {
+		if o.Type == CFWProtectObjectTypeInternet {
+			return o.ObjectID
+		}
+	}
+	return ""
+}
+
+func (r *reader) ListCFWFirewalls(ctx context.Context, page Page) ([]CFWFirewall, string, error) {
+	q := offsetQuery(page)
+	q.Set("service_type", strconv.Itoa(cfwServiceTypeNorthSouth))
+
+	var body struct {
+		Data struct {
+			Records []CFWFirewall `json:"records"`
+		} `json:"data"`
+	}
+
+	err := r.get(ctx, "cfw", "v1/{project_id}/firewall/exist", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Data.Records, nextOffset(page, len(body.Data.Records)), nil
+}
+
+// CFWProtectionRule is an ACL protection rule of a CFW protected object
+type CFWProtectionRule struct {
+	ID     string `json:"rule_id"`
+	Name   string `json:"name"`
+	Status int    `json:"status"`
+}
+
+func (r *reader) ListCFWProtectionRules(ctx context.Context, objectID string, page Page) ([]CFWProtectionRule, string, error) {
+	q := offsetQuery(page)
+	q.Set("object_id", objectID)
+
+	var body struct {
+		Data struct {
+			Records []CFWProtectionRule `json:"records"`
+		} `json:"data"`
+	}
+
+	err := r.get(ctx, "cfw", "v1/{project_id}/acl-rules", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Data.Records, nextOffset(page, len(body.Data.Records)), nil
+}
@@ -0,0 +1,26 @@
+package reader
+
+import "context"
+
+// CPHServer is a server of the Cloud Phone Host, the Status
+// is a code (ex: 5 when running, 0, 1, 3 and 4 when creating)
+type CPHServer struct {
+	ID       string `json:"server_id"`
+	Name     string `json:"server_name"`
+	Status   int    `json:"status"`
+	VPCID    string `json:"vpc_id"`
+	SubnetID string `json:"subnet_id"`
+}
+
+func (r *reader) ListCPHServers(ctx context.Context, page Page) ([]CPHServer, string, error) {
+	var body struct {
+		Servers []CPHServer `json:"servers"`
+	}
+
+	err := r.get(ctx, "cph", "v1/{project_id}/cloud-phone/servers", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Servers, nextOffset(page, len(body.Servers)), nil
+}
@@ -0,0 +1,28 @@
+package reader
+
+import "context"
+
+// DBSSInstance is an audit instance of the Database Security Service,
+// the ID is the resource ID used by the Terraform provider
+type DBSSInstance struct {
+	ID              string `json:"resource_id"`
+	InstanceID      string `json:"id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	VPCID           string `json:"vpc_id"`
+	SubnetID        string `json:"subnetId"`
+	SecurityGroupID string `json:"security_group_id"`
+}
+
+func (r *reader) ListDBSSInstances(ctx context.Context) ([]DBSSInstance, error) {
+	var body struct {
+		Servers []DBSSInstance `json:"servers"`
+	}
+
+	err := r.get(ctx, "dbss", "v1/{project_id}/dbss/audit/instances", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Servers, nil
+}
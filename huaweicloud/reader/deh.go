@@ -0,0 +1,29 @@
+package reader
+
+import "context"
+
+// DeHHost is a Dedicated Host, a physical
+// server where the ECS instances can be placed
+type DeHHost struct {
+	ID    string `json:"dedicated_host_id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+func (r *reader) ListDeHHosts(ctx context.Context, page Page) ([]DeHHost, string, error) {
+	var body struct {
+		Hosts []DeHHost `json:"dedicated_hosts"`
+	}
+
+	err := r.get(ctx, "deh", "v1.0/{project_id}/dedicated-hosts", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Hosts); n != 0 {
+		last = body.Hosts[n-1].ID
+	}
+
+	return body.Hosts, nextMarker(page, len(body.Hosts), last), nil
+}
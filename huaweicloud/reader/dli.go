@@ -0,0 +1,64 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// DLIDatabase is a database of the Data Lake Insight,
+// which are identified by their name
+type DLIDatabase struct {
+	Name        string `json:"database_name"`
+	Owner       string `json:"owner"`
+	TableNumber int    `json:"table_number"`
+}
+
+// DLIDefaultDatabase is the database that
+// DLI creates on each project
+const DLIDefaultDatabase = "default"
+
+// DLITable is a table of a DLIDatabase,
+// the TableType is 'MANAGED', 'EXTERNAL' or 'VIEW'
+type DLITable struct {
+	Name      string `json:"table_name"`
+	TableType string `json:"table_type"`
+}
+
+func (r *reader) ListDLIDatabases(ctx context.Context, page Page) ([]DLIDatabase, string, error) {
+	var body struct {
+		Databases []DLIDatabase `json:"databases"`
+	}
+
+	err := r.get(ctx, "dli", "v1.0/{project_id}/databases", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Databases, nextOffset(page, len(body.Databases)), nil
+}
+
+func (r *reader) ListDLITables(ctx context.Context, database string, page Page) ([]DLITable, string, error) {
+	var body struct {
+		Tables []DLITable `json:"tables"`
+	}
+
+	// The tables are paginated with 'page-size' and 'current-page',
+	// the details (columns) are not needed to import them
+	q := url.Values{}
+	q.Set("with-detail", "false")
+	q.Set("page-size", strconv.Itoa(page.limit()))
+	if page.Marker != "" {
+		q.Set("current-page", page.Marker)
+	} else {
+		q.Set("current-page", "1")
+	}
+
+	err := r.get(ctx, "dli", fmt.Sprintf("v1.0/{project_id}/databases/%s/tables", url.PathEscape(database)), q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Tables, nextPageNumber(page, len(body.Tables)), nil
+}
@@ -0,0 +1,131 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+)
+
+// DMSRocketMQInstance is a RocketMQ instance of the Distributed
+// Message Service, the NodeNum are all the nodes of the
+// instance which are the brokers and the name servers
+type DMSRocketMQInstance struct {
+	ID              string `json:"instance_id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	VPCID           string `json:"vpc_id"`
+	SubnetID        string `json:"subnet_id"`
+	SecurityGroupID string `json:"security_group_id"`
+	BrokerNum       int    `json:"broker_num"`
+	NodeNum         int    `json:"node_num"`
+}
+
+// DMSKafkaInstance is a Kafka instance of the Distributed Message Service
+type DMSKafkaInstance struct {
+	ID              string `json:"instance_id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	CreatedAt       string `json:"created_at"`
+	VPCID           string `json:"vpc_id"`
+	SubnetID        string `json:"subnet_id"`
+	SecurityGroupID string `json:"security_group_id"`
+	BrokerNum       int    `json:"broker_num"`
+}
+
+// DMSKafkaTopic is a topic of a DMS Kafka instance,
+// the topics are identified by their name
+type DMSKafkaTopic struct {
+	Name       string `json:"name"`
+	Partitions int    `json:"partition"`
+	Replicas   int    `json:"replication"`
+}
+
+// DMSKafkaConsumerGroup is a consumer group of a DMS Kafka
+// instance, the groups are identified by their name
+type DMSKafkaConsumerGroup struct {
+	Name        string `json:"group_id"`
+	State       string `json:"state"`
+	Description string `json:"group_desc"`
+}
+
+// dmsMaxLimit is the maximum page size of the DMS
+const dmsMaxLimit = 50
+
+// dmsRocketMQEngine is the DMS engine of the RocketMQ instances
+const dmsRocketMQEngine = "reliability"
+
+// dmsKafkaEngine is the DMS engine of the Kafka instances
+const dmsKafkaEngine = "kafka"
+
+func (r *reader) ListDMSRocketMQInstances(ctx context.Context, page Page) ([]DMSRocketMQInstance, string, error) {
+	if page.limit() > dmsMaxLimit {
+		page.Limit = dmsMaxLimit
+	}
+
+	q := offsetQuery(page)
+	q.Set("engine", dmsRocketMQEngine)
+
+	var body struct {
+		Instances []DMSRocketMQInstance `json:"instances"`
+	}
+
+	err := r.get(ctx, "dmsv2", "v2/{project_id}/instances", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Instances, nextOffset(page, len(body.Instances)), nil
+}
+
+func (r *reader) ListDMSKafkaInstances(ctx context.Context, page Page) ([]DMSKafkaInstance, string, error) {
+	if page.limit() > dmsMaxLimit {
+		page.Limit = dmsMaxLimit
+	}
+
+	q := offsetQuery(page)
+	q.Set("engine", dmsKafkaEngine)
+
+	var body struct {
+		Instances []DMSKafkaInstance `json:"instances"`
+	}
+
+	err := r.get(ctx, "dmsv2", "v2/{project_id}/instances", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Instances, nextOffset(page, len(body.Instances)), nil
+}
+
+func (r *reader) ListDMSKafkaTopics(ctx context.Context, instanceID string, page Page) ([]DMSKafkaTopic, string, error) {
+	if page.limit() > dmsMaxLimit {
+		page.Limit = dmsMaxLimit
+	}
+
+	var body struct {
+		Topics []DMSKafkaTopic `json:"topics"`
+	}
+
+	err := r.get(ctx, "dmsv2", fmt.Sprintf("v2/{project_id}/instances/%s/topics", instanceID), offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Topics, nextOffset(page, len(body.Topics)), nil
+}
+
+func (r *reader) ListDMSKafkaConsumerGroups(ctx context.Context, instanceID string, page Page) ([]DMSKafkaConsumerGroup, string, error) {
+	if page.limit() > dmsMaxLimit {
+		page.Limit = dmsMaxLimit
+	}
+
+	var body struct {
+		Groups []DMSKafkaConsumerGroup `json:"groups"`
+	}
+
+	err := r.get(ctx, "dmsv2", fmt.Sprintf("v2/{project_id}/instances/%s/groups", instanceID), offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Groups, nextOffset(page, len(body.Groups)), nil
+}
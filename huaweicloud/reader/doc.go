@@ -0,0 +1,3 @@
+// Package reader implements the calls to the Huawei Cloud APIs used
+// to enumerate the resources that will be imported.
+package reader
@@ -0,0 +1,74 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+)
+
+// DRSJob is a job of the Data Replication Service
+type DRSJob struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	DBUseType  string `json:"db_use_type"`
+	EngineType string `json:"engine_type"`
+}
+
+// DRSJobDetail is the detail of a DRSJob with
+// the databases it replicates from and to
+type DRSJobDetail struct {
+	ID             string      `json:"id"`
+	SourceEndpoint DRSEndpoint `json:"source_endpoint"`
+	TargetEndpoint DRSEndpoint `json:"target_endpoint"`
+}
+
+// DRSEndpoint is a database of a DRSJobDetail, the
+// InstanceID is defined when the database is an RDS instance
+type DRSEndpoint struct {
+	InstanceID   string `json:"inst_id"`
+	InstanceName string `json:"inst_name"`
+	IP           string `json:"ip"`
+}
+
+func (r *reader) ListDRSJobs(ctx context.Context, dbUseType string, page Page) ([]DRSJob, string, error) {
+	// The DRS pagination is done on the body and
+	// the Marker holds the page number
+	cur := 1
+	if page.Marker != "" {
+		cur, _ = strconv.Atoi(page.Marker)
+	}
+
+	req := map[string]interface{}{
+		"cur_page":    cur,
+		"per_page":    page.limit(),
+		"db_use_type": dbUseType,
+	}
+
+	var body struct {
+		Jobs []DRSJob `json:"jobs"`
+	}
+
+	err := r.post(ctx, "drs", "v3/{project_id}/jobs", req, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Jobs, nextPageNumber(page, len(body.Jobs)), nil
+}
+
+func (r *reader) GetDRSJobDetails(ctx context.Context, ids []string) ([]DRSJobDetail, error) {
+	req := map[string]interface{}{
+		"jobs": ids,
+	}
+
+	var body struct {
+		Results []DRSJobDetail `json:"results"`
+	}
+
+	err := r.post(ctx, "drs", "v3/{project_id}/jobs/batch-detail", req, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Results, nil
+}
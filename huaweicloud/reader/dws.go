@@ -0,0 +1,29 @@
+package reader
+
+import "context"
+
+// DWSCluster is a cluster of the Data Warehouse Service
+type DWSCluster struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	Created         string `json:"created"`
+	VPCID           string `json:"vpc_id"`
+	SubnetID        string `json:"subnet_id"`
+	SecurityGroupID string `json:"security_group_id"`
+	NodeType        string `json:"node_type"`
+	NumberOfNode    int    `json:"number_of_node"`
+}
+
+func (r *reader) ListDWSClusters(ctx context.Context) ([]DWSCluster, error) {
+	var body struct {
+		Clusters []DWSCluster `json:"clusters"`
+	}
+
+	err := r.get(ctx, "dws", "v1.0/{project_id}/clusters", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Clusters, nil
+}
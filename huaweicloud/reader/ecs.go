@@ -0,0 +1,147 @@
+package reader
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Server is an ECS instance
+type Server struct {
+	ID                  string       `json:"id"`
+	Name                string       `json:"name"`
+	Status              string       `json:"status"`
+	Created             string       `json:"created"`
+	Flavor              ServerFlavor `json:"flavor"`
+	AvailabilityZone    string       `json:"OS-EXT-AZ:availability_zone"`
+	EnterpriseProjectID string       `json:"enterprise_project_id"`
+
+	// Tags are the tags of the tag service with the format 'KEY=VALUE'
+	Tags []string `json:"tags"`
+
+	// Metadata is the instance metadata, which has also
+	// system keys from the image and the charging
+	Metadata map[string]string `json:"metadata"`
+
+	SchedulerHints ServerSchedulerHints `json:"os:scheduler_hints"`
+}
+
+// ServerSchedulerHints are the placement of a Server, the API
+// returns the values as lists of at most one element
+type ServerSchedulerHints struct {
+	Group           []string `json:"group"`
+	FaultDomain     []string `json:"fault_domain"`
+	Tenancy         []string `json:"tenancy"`
+	DedicatedHostID []string `json:"dedicated_host_id"`
+}
+
+// First returns the first value of each of the hints,
+// empty if the Server does not have it
+func (h ServerSchedulerHints) First() (group, faultDomain, tenancy, dedicatedHostID string) {
+	first := func(vs []string) string {
+		if len(vs) == 0 {
+			return ""
+		}
+		return vs[0]
+	}
+	return first(h.Group), first(h.FaultDomain), first(h.Tenancy), first(h.DedicatedHostID)
+}
+
+// ServerMarketInfo is the billing market of a Server, the
+// SpotOptions are only defined for the spot (bidding) instances
+type ServerMarketInfo struct {
+	MarketType  string            `json:"market_type"`
+	SpotOptions ServerSpotOptions `json:"spot_options"`
+}
+
+// ServerSpotOptions are the bidding options of a spot Server, the
+// SpotPrice is empty when the bid is the market price and the
+// SpotDurationHours is 0 if the instance has no predefined duration
+type ServerSpotOptions struct {
+	SpotPrice         string `json:"spot_price"`
+	SpotDurationHours int    `json:"spot_duration_hours"`
+	SpotDurationCount int    `json:"spot_duration_count"`
+}
+
+// ServerFlavor is the flavor information of a Server,
+// the API returns the VCPUs and RAM as strings
+type ServerFlavor struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	VCPUs string `json:"vcpus"`
+	RAM   string `json:"ram"`
+}
+
+// Flavor is an ECS flavor
+type Flavor struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	VCPUs      string            `json:"vcpus"`
+	RAM        int               `json:"ram"`
+	ExtraSpecs map[string]string `json:"os_extra_specs"`
+}
+
+// FlavorStatusKey is the key of the Flavor.ExtraSpecs
+// that holds the sale status of the flavor
+const FlavorStatusKey = "cond:operation:status"
+
+// Available checks if the Flavor can still be used to create
+// instances, the flavors that are 'abandon' (deprecated) or
+// 'sellout' (sold out) are not available
+func (f Flavor) Available() bool {
+	switch f.ExtraSpecs[FlavorStatusKey] {
+	case "abandon", "sellout":
+		return false
+	}
+	return true
+}
+
+func (r *reader) ListServers(ctx context.Context, page Page) ([]Server, string, error) {
+	var body struct {
+		Servers []Server `json:"servers"`
+	}
+
+	err := r.get(ctx, "ecs", "v1/{project_id}/cloudservers/detail", pageNumberQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Servers, nextPageNumber(page, len(body.Servers)), nil
+}
+
+func (r *reader) GetServerMarketInfo(ctx context.Context, id string) (ServerMarketInfo, error) {
+	var body struct {
+		Servers []struct {
+			MarketInfo ServerMarketInfo `json:"market_info"`
+		} `json:"servers"`
+	}
+
+	q := url.Values{}
+	q.Set("id", id)
+	q.Set("expect-fields", "market_info")
+
+	err := r.get(ctx, "ecs", "v1.1/{project_id}/cloudservers/detail", q, &body)
+	if err != nil {
+		return ServerMarketInfo{}, err
+	}
+
+	if len(body.Servers) == 0 {
+		return ServerMarketInfo{}, errors.Errorf("the ECS instance %q was not found", id)
+	}
+
+	return body.Servers[0].MarketInfo, nil
+}
+
+func (r *reader) ListFlavors(ctx context.Context) ([]Flavor, error) {
+	var body struct {
+		Flavors []Flavor `json:"flavors"`
+	}
+
+	err := r.get(ctx, "ecs", "v1/{project_id}/cloudservers/flavors", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Flavors, nil
+}
@@ -0,0 +1,55 @@
+package reader
+
+import "context"
+
+// EGEventChannel is a custom event channel of the EventGrid
+type EGEventChannel struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	ProviderType        string `json:"provider_type"`
+	EnterpriseProjectID string `json:"eps_id"`
+}
+
+// egProviderTypeCustom is the provider type of the custom
+// event channels, the official ones are created by Huawei Cloud
+const egProviderTypeCustom = "CUSTOM"
+
+func (r *reader) ListEGEventChannels(ctx context.Context, page Page) ([]EGEventChannel, string, error) {
+	q := offsetQuery(page)
+	q.Set("provider_type", egProviderTypeCustom)
+
+	var body struct {
+		Items []EGEventChannel `json:"items"`
+	}
+
+	err := r.get(ctx, "eg", "v1/{project_id}/channels", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Items, nextOffset(page, len(body.Items)), nil
+}
+
+// EGEventSubscription is an event subscription of an EventGrid channel
+type EGEventSubscription struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	ChannelID string `json:"channel_id"`
+}
+
+func (r *reader) ListEGEventSubscriptions(ctx context.Context, channelID string, page Page) ([]EGEventSubscription, string, error) {
+	q := offsetQuery(page)
+	q.Set("channel_id", channelID)
+
+	var body struct {
+		Items []EGEventSubscription `json:"items"`
+	}
+
+	err := r.get(ctx, "eg", "v1/{project_id}/subscriptions", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Items, nextOffset(page, len(body.Items)), nil
+}
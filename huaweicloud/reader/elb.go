@@ -0,0 +1,202 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ELBCertificate is a certificate of the dedicated Elastic
+// Load Balance, the private key is never returned by the API
+type ELBCertificate struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+}
+
+func (r *reader) ListELBCertificates(ctx context.Context, page Page) ([]ELBCertificate, string, error) {
+	var body struct {
+		Certificates []ELBCertificate `json:"certificates"`
+	}
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/certificates", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Certificates); n != 0 {
+		last = body.Certificates[n-1].ID
+	}
+
+	return body.Certificates, nextMarker(page, len(body.Certificates), last), nil
+}
+
+// ELBIPGroup is an IP address group of the dedicated Elastic Load
+// Balance, used by the listeners as whitelist or blacklist
+type ELBIPGroup struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Listeners []ELBResourceRef `json:"listeners"`
+}
+
+// ELBResourceRef is the reference to another ELB resource
+type ELBResourceRef struct {
+	ID string `json:"id"`
+}
+
+func (r *reader) ListELBIPGroups(ctx context.Context, page Page) ([]ELBIPGroup, string, error) {
+	var body struct {
+		IPGroups []ELBIPGroup `json:"ipgroups"`
+	}
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/ipgroups", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.IPGroups); n != 0 {
+		last = body.IPGroups[n-1].ID
+	}
+
+	return body.IPGroups, nextMarker(page, len(body.IPGroups), last), nil
+}
+
+// ELBSecurityPolicy is a custom TLS security policy of the
+// dedicated Elastic Load Balance, used by the HTTPS listeners
+type ELBSecurityPolicy struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Listeners []ELBResourceRef `json:"listeners"`
+}
+
+func (r *reader) ListELBSecurityPolicies(ctx context.Context, page Page) ([]ELBSecurityPolicy, string, error) {
+	var body struct {
+		Policies []ELBSecurityPolicy `json:"security_policies"`
+	}
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/security-policies", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Policies); n != 0 {
+		last = body.Policies[n-1].ID
+	}
+
+	return body.Policies, nextMarker(page, len(body.Policies), last), nil
+}
+
+// ELBPool is a backend server group of the dedicated Elastic
+// Load Balance, with the health check monitoring its members
+type ELBPool struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	HealthMonitorID string `json:"healthmonitor_id"`
+}
+
+func (r *reader) ListELBPools(ctx context.Context, page Page) ([]ELBPool, string, error) {
+	var body struct {
+		Pools []ELBPool `json:"pools"`
+	}
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/pools", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Pools); n != 0 {
+		last = body.Pools[n-1].ID
+	}
+
+	return body.Pools, nextMarker(page, len(body.Pools), last), nil
+}
+
+// ELBListener is a listener of the dedicated Elastic Load Balance,
+// the Protocol is the one of the frontend (ex: 'HTTPS')
+type ELBListener struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+}
+
+func (r *reader) ListELBListeners(ctx context.Context, page Page) ([]ELBListener, string, error) {
+	var body struct {
+		Listeners []ELBListener `json:"listeners"`
+	}
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/listeners", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Listeners); n != 0 {
+		last = body.Listeners[n-1].ID
+	}
+
+	return body.Listeners, nextMarker(page, len(body.Listeners), last), nil
+}
+
+// ELBL7Policy is a forwarding (L7) policy of an HTTP or HTTPS
+// ELBListener, the Rules are the conditions of the policy
+type ELBL7Policy struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	ListenerID string           `json:"listener_id"`
+	Action     string           `json:"action"`
+	Rules      []ELBResourceRef `json:"rules"`
+}
+
+func (r *reader) ListELBL7Policies(ctx context.Context, listenerID string, page Page) ([]ELBL7Policy, string, error) {
+	var body struct {
+		Policies []ELBL7Policy `json:"l7policies"`
+	}
+
+	q := markerQuery(page)
+	q.Set("listener_id", listenerID)
+
+	err := r.get(ctx, "elb", "v3/{project_id}/elb/l7policies", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Policies); n != 0 {
+		last = body.Policies[n-1].ID
+	}
+
+	return body.Policies, nextMarker(page, len(body.Policies), last), nil
+}
+
+// ELBL7Rule is a forwarding rule of an ELBL7Policy, the Type is
+// what is compared (ex: 'PATH') with the Value by the CompareType
+type ELBL7Rule struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	CompareType string `json:"compare_type"`
+	Value       string `json:"value"`
+}
+
+func (r *reader) ListELBL7Rules(ctx context.Context, policyID string, page Page) ([]ELBL7Rule, string, error) {
+	var body struct {
+		Rules []ELBL7Rule `json:"rules"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/elb/l7policies/%s/rules", url.PathEscape(policyID))
+	err := r.get(ctx, "elb", path, markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Rules); n != 0 {
+		last = body.Rules[n-1].ID
+	}
+
+	return body.Rules, nextMarker(page, len(body.Rules), last), nil
+}
@@ -0,0 +1,34 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+)
+
+// EnterpriseProject is a project of the Enterprise Project
+// Management Service, the default one has the ID '0'
+type EnterpriseProject struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status int    `json:"status"`
+}
+
+// EnterpriseProjectStatusEnabled is the EnterpriseProject.Status
+// of the enabled projects
+const EnterpriseProjectStatusEnabled = 1
+
+func (r *reader) ListEnterpriseProjects(ctx context.Context, page Page) ([]EnterpriseProject, string, error) {
+	var body struct {
+		EnterpriseProjects []EnterpriseProject `json:"enterprise_projects"`
+	}
+
+	q := offsetQuery(page)
+	q.Set("status", strconv.Itoa(EnterpriseProjectStatusEnabled))
+
+	err := r.get(ctx, "eps", "v1.0/enterprise-projects", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.EnterpriseProjects, nextOffset(page, len(body.EnterpriseProjects)), nil
+}
@@ -0,0 +1,48 @@
+package reader
+
+import "context"
+
+// EVSVolume is a disk of the Elastic Volume Service
+type EVSVolume struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Status      string                `json:"status"`
+	CreatedAt   string                `json:"created_at"`
+	Bootable    string                `json:"bootable"`
+	VolumeType  string                `json:"volume_type"`
+	Size        int                   `json:"size"`
+	Attachments []EVSVolumeAttachment `json:"attachments"`
+
+	// Multiattach is true for the shared volumes,
+	// which can be attached to many ECS instances
+	Multiattach bool `json:"multiattach"`
+
+	// Metadata has the 'orderID' for the
+	// yearly/monthly (prePaid) volumes
+	Metadata map[string]string `json:"metadata"`
+}
+
+// EVSVolumeAttachment is the attachment of an EVSVolume to an ECS instance
+type EVSVolumeAttachment struct {
+	ServerID string `json:"server_id"`
+	Device   string `json:"device"`
+}
+
+// SystemDisk checks if the volume is the system disk of
+// an ECS instance, which is bootable and attached to it
+func (v EVSVolume) SystemDisk() bool {
+	return v.Bootable == "true" && len(v.Attachments) != 0
+}
+
+func (r *reader) ListEVSVolumes(ctx context.Context, page Page) ([]EVSVolume, string, error) {
+	var body struct {
+		Volumes []EVSVolume `json:"volumes"`
+	}
+
+	err := r.get(ctx, "evs", "v2/{project_id}/cloudvolumes/detail", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Volumes, nextOffset(page, len(body.Volumes)), nil
+}
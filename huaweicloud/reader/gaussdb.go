@@ -0,0 +1,38 @@
+package reader
+
+import "context"
+
+// GaussDBOpenGaussInstance is an instance of GaussDB(for openGauss),
+// the distributed ones have many Nodes (CN and DN)
+type GaussDBOpenGaussInstance struct {
+	ID                  string        `json:"id"`
+	Name                string        `json:"name"`
+	Status              string        `json:"status"`
+	Type                string        `json:"type"`
+	VPCID               string        `json:"vpc_id"`
+	SubnetID            string        `json:"subnet_id"`
+	SecurityGroupID     string        `json:"security_group_id"`
+	Nodes               []GaussDBNode `json:"nodes"`
+	EnterpriseProjectID string        `json:"enterprise_project_id"`
+}
+
+// GaussDBNode is a node of a GaussDBOpenGaussInstance
+type GaussDBNode struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Role             string `json:"role"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+func (r *reader) ListGaussDBOpenGaussInstances(ctx context.Context, page Page) ([]GaussDBOpenGaussInstance, string, error) {
+	var body struct {
+		Instances []GaussDBOpenGaussInstance `json:"instances"`
+	}
+
+	err := r.get(ctx, "opengauss", "v3/{project_id}/instances", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Instances, nextOffset(page, len(body.Instances)), nil
+}
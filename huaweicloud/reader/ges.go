@@ -0,0 +1,27 @@
+package reader
+
+import "context"
+
+// GESGraph is a graph of the Graph Engine Service,
+// the Status is a code (ex: '200' when running)
+type GESGraph struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	VPCID           string `json:"vpc_id"`
+	SubnetID        string `json:"subnet_id"`
+	SecurityGroupID string `json:"security_group_id"`
+}
+
+func (r *reader) ListGESGraphs(ctx context.Context, page Page) ([]GESGraph, string, error) {
+	var body struct {
+		Graphs []GESGraph `json:"graphs"`
+	}
+
+	err := r.get(ctx, "ges", "v2/{project_id}/graphs", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Graphs, nextOffset(page, len(body.Graphs)), nil
+}
@@ -0,0 +1,62 @@
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// IdentityRole is an IAM custom policy, the Policy
+// is the raw JSON document as returned by the API
+type IdentityRole struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"display_name"`
+	Type        string          `json:"type"`
+	Description string          `json:"description"`
+	Policy      json.RawMessage `json:"policy"`
+}
+
+func (r *reader) ListIdentityRoles(ctx context.Context, page Page) ([]IdentityRole, string, error) {
+	var body struct {
+		Roles []IdentityRole `json:"roles"`
+	}
+
+	// The IAM API is paginated with 'page' and 'per_page'
+	q := url.Values{}
+	q.Set("per_page", strconv.Itoa(page.limit()))
+	if page.Marker != "" {
+		q.Set("page", page.Marker)
+	} else {
+		q.Set("page", "1")
+	}
+
+	err := r.get(ctx, "iam", "v3.0/OS-ROLE/roles", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Roles, nextPageNumber(page, len(body.Roles)), nil
+}
+
+// Project is an IAM project of the account, there is one per
+// region named as it (ex: 'cn-north-4') and its subprojects
+// are named 'REGION_NAME'
+type Project struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (r *reader) ListProjects(ctx context.Context) ([]Project, error) {
+	var body struct {
+		Projects []Project `json:"projects"`
+	}
+
+	err := r.get(ctx, "iam", "v3/auth/projects", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Projects, nil
+}
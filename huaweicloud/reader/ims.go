@@ -0,0 +1,54 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+)
+
+// IMSImage is a private image of the Image Management Service
+type IMSImage struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// IMSImageMember is a project an IMS image is shared with,
+// the Status is 'pending', 'accepted' or 'rejected'
+type IMSImageMember struct {
+	ProjectID string `json:"member_id"`
+	Status    string `json:"status"`
+}
+
+func (r *reader) ListIMSPrivateImages(ctx context.Context, page Page) ([]IMSImage, string, error) {
+	var body struct {
+		Images []IMSImage `json:"images"`
+	}
+
+	q := markerQuery(page)
+	q.Set("__imagetype", "private")
+
+	err := r.get(ctx, "ims", "v2/cloudimages", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Images); n != 0 {
+		last = body.Images[n-1].ID
+	}
+
+	return body.Images, nextMarker(page, len(body.Images), last), nil
+}
+
+func (r *reader) ListIMSImageMembers(ctx context.Context, imageID string) ([]IMSImageMember, error) {
+	var body struct {
+		Members []IMSImageMember `json:"members"`
+	}
+
+	err := r.get(ctx, "ims", fmt.Sprintf("v2/images/%s/members", imageID), nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Members, nil
+}
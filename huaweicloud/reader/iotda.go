@@ -0,0 +1,65 @@
+package reader
+
+import "context"
+
+// IoTDAProduct is a product (device model)
+// of the IoT Device Access
+type IoTDAProduct struct {
+	ID         string `json:"product_id"`
+	Name       string `json:"name"`
+	AppID      string `json:"app_id"`
+	DeviceType string `json:"device_type"`
+}
+
+// IoTDADevice is a device of an IoTDAProduct
+type IoTDADevice struct {
+	ID        string `json:"device_id"`
+	Name      string `json:"device_name"`
+	NodeID    string `json:"node_id"`
+	ProductID string `json:"product_id"`
+	AppID     string `json:"app_id"`
+}
+
+// iotdaMaxLimit is the maximum page size of the IoTDA
+const iotdaMaxLimit = 50
+
+func (r *reader) ListIoTDAProducts(ctx context.Context, page Page) ([]IoTDAProduct, string, error) {
+	if page.limit() > iotdaMaxLimit {
+		page.Limit = iotdaMaxLimit
+	}
+
+	var body struct {
+		Products []IoTDAProduct `json:"products"`
+	}
+
+	err := r.get(ctx, "iotda", "v5/iot/{project_id}/products", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Products, nextOffset(page, len(body.Products)), nil
+}
+
+func (r *reader) ListIoTDADevices(ctx context.Context, productID string, page Page) ([]IoTDADevice, string, error) {
+	if page.limit() > iotdaMaxLimit {
+		page.Limit = iotdaMaxLimit
+	}
+
+	var body struct {
+		Devices []IoTDADevice `json:"devices"`
+		Page    struct {
+			Marker string `json:"marker"`
+		} `json:"page"`
+	}
+
+	q := markerQuery(page)
+	q.Set("product_id", productID)
+
+	err := r.get(ctx, "iotda", "v5/iot/{project_id}/devices", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The marker of the next page is returned by the API
+	return body.Devices, nextMarker(page, len(body.Devices), body.Page.Marker), nil
+}
@@ -0,0 +1,35 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+)
+
+// ModelArtsNotebook is a notebook instance of ModelArts
+type ModelArtsNotebook struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Flavor string `json:"flavor"`
+}
+
+func (r *reader) ListModelArtsNotebooks(ctx context.Context, page Page) ([]ModelArtsNotebook, string, error) {
+	var body struct {
+		Data []ModelArtsNotebook `json:"data"`
+	}
+
+	// The 'offset' of the notebooks is the
+	// index of the page starting at 0
+	err := r.get(ctx, "modelarts", "v1/{project_id}/notebooks", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(body.Data) == page.limit() {
+		index, _ := strconv.Atoi(page.Marker)
+		next = strconv.Itoa(index + 1)
+	}
+
+	return body.Data, next, nil
+}
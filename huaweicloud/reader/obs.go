@@ -0,0 +1,158 @@
+package reader
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/pkg/errors"
+)
+
+// OBSBucket is a bucket of the Object Storage Service
+type OBSBucket struct {
+	Name     string
+	Location string
+	Type     string
+}
+
+// OBSBucketLogging is the access logging configuration of
+// an OBS bucket, the TargetBucket is empty if it's disabled
+type OBSBucketLogging struct {
+	TargetBucket string
+	TargetPrefix string
+	Agency       string
+}
+
+// OBSBucketMetadata are the attributes of an OBS bucket
+// set on its creation, which can not be changed later
+type OBSBucketMetadata struct {
+	// MultiAZ is true if the data is
+	// stored on 3 availability zones
+	MultiAZ bool
+
+	// ParallelFS is true if the bucket is
+	// a parallel file system (POSIX)
+	ParallelFS bool
+}
+
+// OBSBucketStorage are the storage quota and the payer
+// of the requests of an OBS bucket, zero if not configured
+type OBSBucketStorage struct {
+	// Quota is the storage quota in bytes,
+	// it's 0 if the bucket has no quota
+	Quota int64
+
+	// RequesterPays is true if the requests and the
+	// traffic are paid by the requester of them
+	RequesterPays bool
+}
+
+func (r *reader) ListOBSBuckets(ctx context.Context, page Page) ([]OBSBucket, string, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := conf.ObjectStorageClient(conf.Region)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unable to create the OBS client")
+	}
+
+	out, err := client.ListBuckets(&obs.ListBucketsInput{
+		QueryLocation: true,
+		MaxKeys:       page.limit(),
+		Marker:        page.Marker,
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unable to list the OBS buckets")
+	}
+
+	buckets := make([]OBSBucket, 0, len(out.Buckets))
+	for _, b := range out.Buckets {
+		buckets = append(buckets, OBSBucket{
+			Name:     b.Name,
+			Location: b.Location,
+			Type:     b.BucketType,
+		})
+	}
+
+	var next string
+	if out.IsTruncated {
+		next = out.NextMarker
+	}
+
+	return buckets, next, nil
+}
+
+func (r *reader) GetOBSBucketLogging(ctx context.Context, bucket string) (OBSBucketLogging, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return OBSBucketLogging{}, err
+	}
+
+	// The logging configuration is only
+	// returned with the OBS signature
+	client, err := conf.ObjectStorageClientWithSignature(conf.Region)
+	if err != nil {
+		return OBSBucketLogging{}, errors.Wrap(err, "unable to create the OBS client")
+	}
+
+	out, err := client.GetBucketLoggingConfiguration(bucket)
+	if err != nil {
+		return OBSBucketLogging{}, errors.Wrapf(err, "unable to get the logging of the OBS bucket %q", bucket)
+	}
+
+	return OBSBucketLogging{
+		TargetBucket: out.TargetBucket,
+		TargetPrefix: out.TargetPrefix,
+		Agency:       out.Agency,
+	}, nil
+}
+
+func (r *reader) GetOBSBucketMetadata(ctx context.Context, bucket string) (OBSBucketMetadata, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return OBSBucketMetadata{}, err
+	}
+
+	client, err := conf.ObjectStorageClient(conf.Region)
+	if err != nil {
+		return OBSBucketMetadata{}, errors.Wrap(err, "unable to create the OBS client")
+	}
+
+	out, err := client.GetBucketMetadata(&obs.GetBucketMetadataInput{Bucket: bucket})
+	if err != nil {
+		return OBSBucketMetadata{}, errors.Wrapf(err, "unable to get the metadata of the OBS bucket %q", bucket)
+	}
+
+	return OBSBucketMetadata{
+		MultiAZ:    out.AZRedundancy == obs.AvailableZoneMultiAz,
+		ParallelFS: out.FSStatus == obs.FSStatusEnabled,
+	}, nil
+}
+
+func (r *reader) GetOBSBucketStorage(ctx context.Context, bucket string) (OBSBucketStorage, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return OBSBucketStorage{}, err
+	}
+
+	client, err := conf.ObjectStorageClient(conf.Region)
+	if err != nil {
+		return OBSBucketStorage{}, errors.Wrap(err, "unable to create the OBS client")
+	}
+
+	q, err := client.GetBucketQuota(bucket)
+	if err != nil {
+		return OBSBucketStorage{}, errors.Wrapf(err, "unable to get the quota of the OBS bucket %q", bucket)
+	}
+
+	pay, err := client.GetBucketRequestPayment(bucket)
+	if err != nil {
+		return OBSBucketStorage{}, errors.Wrapf(err, "unable to get the request payment of the OBS bucket %q", bucket)
+	}
+
+	return OBSBucketStorage{
+		Quota:         q.Quota,
+		RequesterPays: pay.Payer == obs.RequesterPayer,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package reader
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// markerQuery returns the query of a call paginated with
+// 'limit' and 'marker'
+func markerQuery(p Page) url.Values {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(p.limit()))
+	if p.Marker != "" {
+		q.Set("marker", p.Marker)
+	}
+	enterpriseProjectQuery(q, p)
+	return q
+}
+
+// nextMarker returns the Marker of the next page for the calls
+// paginated with 'marker', which is the ID of the last item
+// if the page was full
+func nextMarker(p Page, n int, lastID string) string {
+	if n < p.limit() {
+		return ""
+	}
+	return lastID
+}
+
+// offsetQuery returns the query of a call paginated with
+// 'limit' and 'offset', the Marker holds the offset
+func offsetQuery(p Page) url.Values {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(p.limit()))
+	if p.Marker != "" {
+		q.Set("offset", p.Marker)
+	}
+	enterpriseProjectQuery(q, p)
+	return q
+}
+
+// startNumberQuery returns the query of a call paginated with
+// 'limit' and 'start_number', the Marker holds the start_number
+func startNumberQuery(p Page) url.Values {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(p.limit()))
+	if p.Marker != "" {
+		q.Set("start_number", p.Marker)
+	}
+	enterpriseProjectQuery(q, p)
+	return q
+}
+
+// nextOffset returns the Marker of the next page for the calls
+// paginated with 'offset' or 'start_number'
+func nextOffset(p Page, n int) string {
+	if n < p.limit() {
+		return ""
+	}
+	offset, _ := strconv.Atoi(p.Marker)
+	return strconv.Itoa(offset + n)
+}
+
+// pageNumberQuery returns the query of a call paginated with
+// 'limit' and 'offset' where the offset is the number of the
+// page starting at 1, the Marker holds the page number
+func pageNumberQuery(p Page) url.Values {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(p.limit()))
+	if p.Marker != "" {
+		q.Set("offset", p.Marker)
+	} else {
+		q.Set("offset", "1")
+	}
+	enterpriseProjectQuery(q, p)
+	return q
+}
+
+// pageQuery returns the query of a call paginated with 'limit'
+// and 'page', the number of the page starting at 1, the Marker
+// holds the page number as for the nextPageNumber
+func pageQuery(p Page) url.Values {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(p.limit()))
+	if p.Marker != "" {
+		q.Set("page", p.Marker)
+	} else {
+		q.Set("page", "1")
+	}
+	return q
+}
+
+// nextPageNumber returns the Marker of the next page for the
+// calls paginated with the page number
+func nextPageNumber(p Page, n int) string {
+	if n < p.limit() {
+		return ""
+	}
+	page, _ := strconv.Atoi(p.Marker)
+	if page == 0 {
+		page = 1
+	}
+	return strconv.Itoa(page + 1)
+}
+
+// enterpriseProjectQuery sets the 'enterprise_project_id'
+// of the query q if the Page has one
+func enterpriseProjectQuery(q url.Values, p Page) {
+	if p.EnterpriseProjectID != "" {
+		q.Set("enterprise_project_id", p.EnterpriseProjectID)
+	}
+}
@@ -0,0 +1,214 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// RDSInstance is an instance of the Relational Database Service
+type RDSInstance struct {
+	ID                  string        `json:"id"`
+	Name                string        `json:"name"`
+	Status              string        `json:"status"`
+	Created             string        `json:"created"`
+	Type                string        `json:"type"`
+	Datastore           RDSDatastore  `json:"datastore"`
+	FlavorRef           string        `json:"flavor_ref"`
+	VPCID               string        `json:"vpc_id"`
+	SubnetID            string        `json:"subnet_id"`
+	EnterpriseProjectID string        `json:"enterprise_project_id"`
+	ChargeInfo          RDSChargeInfo `json:"charge_info"`
+	EnableSSL           bool          `json:"enable_ssl"`
+
+	// RelatedInstances are the primary of the
+	// replicas and the replicas of the primaries
+	RelatedInstances []RDSRelatedInstance `json:"related_instance"`
+}
+
+// RDSRelatedInstance is an instance related to an RDSInstance,
+// the Type is 'replica_of' for the primary of a replica
+type RDSRelatedInstance struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// rdsReplicaType is the Type of the read replicas
+const rdsReplicaType = "Replica"
+
+// IsReplica checks if the instance is a read replica
+func (i RDSInstance) IsReplica() bool {
+	return i.Type == rdsReplicaType
+}
+
+// PrimaryID returns the ID of the primary instance
+// of a read replica, empty if it's not a replica
+func (i RDSInstance) PrimaryID() string {
+	for _, ri := range i.RelatedInstances {
+		if ri.Type == "replica_of" {
+			return ri.ID
+		}
+	}
+	return ""
+}
+
+// RDSChargeInfo is the billing information of an RDSInstance,
+// the ChargeMode is 'prePaid' or 'postPaid'
+type RDSChargeInfo struct {
+	ChargeMode string `json:"charge_mode"`
+}
+
+// RDSDatastore is the database engine of an RDSInstance
+type RDSDatastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+func (r *reader) ListRDSInstances(ctx context.Context, page Page) ([]RDSInstance, string, error) {
+	var body struct {
+		Instances []RDSInstance `json:"instances"`
+	}
+
+	err := r.get(ctx, "rds", "v3/{project_id}/instances", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Instances, nextOffset(page, len(body.Instances)), nil
+}
+
+// RDSDatabase is a database of a MySQL RDSInstance
+type RDSDatabase struct {
+	Name         string `json:"name"`
+	CharacterSet string `json:"character_set"`
+	Comment      string `json:"comment"`
+}
+
+func (r *reader) ListRDSDatabases(ctx context.Context, instanceID string, page Page) ([]RDSDatabase, string, error) {
+	var body struct {
+		Databases []RDSDatabase `json:"databases"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/instances/%s/database/detail", url.PathEscape(instanceID))
+	err := r.get(ctx, "rds", path, pageQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Databases, nextPageNumber(page, len(body.Databases)), nil
+}
+
+// RDSAccount is a database account of a MySQL RDSInstance,
+// the API never returns its password
+type RDSAccount struct {
+	Name    string   `json:"name"`
+	Comment string   `json:"comment"`
+	Hosts   []string `json:"hosts"`
+}
+
+func (r *reader) ListRDSAccounts(ctx context.Context, instanceID string, page Page) ([]RDSAccount, string, error) {
+	var body struct {
+		Users []RDSAccount `json:"users"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/instances/%s/db_user/detail", url.PathEscape(instanceID))
+	err := r.get(ctx, "rds", path, pageQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Users, nextPageNumber(page, len(body.Users)), nil
+}
+
+// RDSParameter is a parameter of the configuration of an
+// RDSInstance or of an RDSConfiguration
+type RDSParameter struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Readonly bool   `json:"readonly"`
+}
+
+func (r *reader) ListRDSInstanceParameters(ctx context.Context, instanceID string) ([]RDSParameter, error) {
+	var body struct {
+		Parameters []RDSParameter `json:"configuration_parameters"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/instances/%s/configurations", url.PathEscape(instanceID))
+	err := r.get(ctx, "rds", path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Parameters, nil
+}
+
+// RDSConfiguration is a parameter template of the RDS, the
+// default ones of each datastore are not UserDefined
+type RDSConfiguration struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	DatastoreName        string `json:"datastore_name"`
+	DatastoreVersionName string `json:"datastore_version_name"`
+	UserDefined          bool   `json:"user_defined"`
+}
+
+func (r *reader) ListRDSConfigurations(ctx context.Context) ([]RDSConfiguration, error) {
+	var body struct {
+		Configurations []RDSConfiguration `json:"configurations"`
+	}
+
+	err := r.get(ctx, "rds", "v3/{project_id}/configurations", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Configurations, nil
+}
+
+func (r *reader) ListRDSConfigurationParameters(ctx context.Context, configurationID string) ([]RDSParameter, error) {
+	var body struct {
+		Parameters []RDSParameter `json:"configuration_parameters"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/configurations/%s", url.PathEscape(configurationID))
+	err := r.get(ctx, "rds", path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Parameters, nil
+}
+
+// DBFlavor is a flavor of a database engine, the AZStatus is
+// the sale status of the flavor on each AZ (ex: 'normal')
+type DBFlavor struct {
+	SpecCode string            `json:"spec_code"`
+	VCPUs    string            `json:"vcpus"`
+	RAM      int               `json:"ram"`
+	AZStatus map[string]string `json:"az_status"`
+}
+
+// Available checks if the DBFlavor can still be used to
+// create instances, so it's 'normal' on at least one AZ
+func (f DBFlavor) Available() bool {
+	for _, st := range f.AZStatus {
+		if st == "normal" {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reader) ListRDSFlavors(ctx context.Context, engine string) ([]DBFlavor, error) {
+	var body struct {
+		Flavors []DBFlavor `json:"flavors"`
+	}
+
+	path := fmt.Sprintf("v3/{project_id}/flavors/%s", url.PathEscape(engine))
+	err := r.get(ctx, "rds", path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Flavors, nil
+}
@@ -0,0 +1,393 @@
+package reader
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -destination=../../mock/huaweicloud_reader.go -mock_names=Reader=HuaweicloudReader -package mock github.com/cycloidio/terracognita/huaweicloud/reader Reader
+
+// Reader is the interface defining all the calls done to the Huawei Cloud
+// APIs in order to enumerate the resources to import.
+//
+// The List methods that support pagination receive a Page and return,
+// along with the items, the Marker to use on the next call. An empty
+// Marker means that it was the last page.
+type Reader interface {
+	// ListServers returns a page of the ECS instances of the region
+	ListServers(ctx context.Context, page Page) ([]Server, string, error)
+
+	// GetServerMarketInfo returns the billing market of the
+	// ECS instance, which has the bidding options of the spot ones
+	GetServerMarketInfo(ctx context.Context, id string) (ServerMarketInfo, error)
+
+	// ListFlavors returns all the ECS flavors of the region
+	ListFlavors(ctx context.Context) ([]Flavor, error)
+
+	// ListEVSVolumes returns a page of the EVS volumes of the region
+	ListEVSVolumes(ctx context.Context, page Page) ([]EVSVolume, string, error)
+
+	// ListRDSInstances returns a page of the RDS instances of the region
+	ListRDSInstances(ctx context.Context, page Page) ([]RDSInstance, string, error)
+
+	// ListRDSInstanceParameters returns the parameters of
+	// the configuration of the RDS instance instanceID
+	ListRDSInstanceParameters(ctx context.Context, instanceID string) ([]RDSParameter, error)
+
+	// ListRDSConfigurations returns the RDS parameter
+	// templates of the region, including the default ones
+	ListRDSConfigurations(ctx context.Context) ([]RDSConfiguration, error)
+
+	// ListRDSConfigurationParameters returns the parameters
+	// of the RDS parameter template configurationID
+	ListRDSConfigurationParameters(ctx context.Context, configurationID string) ([]RDSParameter, error)
+
+	// ListRDSDatabases returns a page of the
+	// databases of the MySQL RDS instance instanceID
+	ListRDSDatabases(ctx context.Context, instanceID string, page Page) ([]RDSDatabase, string, error)
+
+	// ListRDSAccounts returns a page of the database
+	// accounts of the MySQL RDS instance instanceID
+	ListRDSAccounts(ctx context.Context, instanceID string, page Page) ([]RDSAccount, string, error)
+
+	// ListRDSFlavors returns the RDS flavors of
+	// the engine (ex: 'mysql') of the region
+	ListRDSFlavors(ctx context.Context, engine string) ([]DBFlavor, error)
+
+	// ListGaussDBOpenGaussInstances returns a page of the
+	// GaussDB(for openGauss) instances of the region
+	ListGaussDBOpenGaussInstances(ctx context.Context, page Page) ([]GaussDBOpenGaussInstance, string, error)
+
+	// ListDRSJobs returns a page of the DRS jobs of the region
+	// with the dbUseType (ex: migration, sync)
+	ListDRSJobs(ctx context.Context, dbUseType string, page Page) ([]DRSJob, string, error)
+
+	// GetDRSJobDetails returns the details of the DRS jobs with the ids
+	GetDRSJobDetails(ctx context.Context, ids []string) ([]DRSJobDetail, error)
+
+	// ListASGroups returns a page of the Auto Scaling groups of the region
+	ListASGroups(ctx context.Context, page Page) ([]ASGroup, string, error)
+
+	// ListASPolicies returns a page of the scaling policies
+	// of the Auto Scaling group groupID
+	ListASPolicies(ctx context.Context, groupID string, page Page) ([]ASPolicy, string, error)
+
+	// ListASLifecycleHooks returns the lifecycle
+	// hooks of the Auto Scaling group groupID
+	ListASLifecycleHooks(ctx context.Context, groupID string) ([]ASLifecycleHook, error)
+
+	// ListCESAlarmRules returns a page of the Cloud Eye alarm rules of the region
+	ListCESAlarmRules(ctx context.Context, page Page) ([]CESAlarmRule, string, error)
+
+	// ListSMNTopics returns a page of the SMN topics of the region
+	ListSMNTopics(ctx context.Context, page Page) ([]SMNTopic, string, error)
+
+	// ListOBSBuckets returns a page of the OBS buckets of all the
+	// regions, OBS lists the buckets of the account independently
+	// of the region of the endpoint
+	ListOBSBuckets(ctx context.Context, page Page) ([]OBSBucket, string, error)
+
+	// GetOBSBucketLogging returns the access logging
+	// configuration of the OBS bucket
+	GetOBSBucketLogging(ctx context.Context, bucket string) (OBSBucketLogging, error)
+
+	// GetOBSBucketMetadata returns the attributes of the
+	// bucket that are set on its creation (ex: multi-AZ)
+	GetOBSBucketMetadata(ctx context.Context, bucket string) (OBSBucketMetadata, error)
+
+	// GetOBSBucketStorage returns the storage quota and
+	// the payer of the requests of the OBS bucket
+	GetOBSBucketStorage(ctx context.Context, bucket string) (OBSBucketStorage, error)
+
+	// ListSFSShares returns a page of the classic SFS
+	// file systems of the region
+	ListSFSShares(ctx context.Context, page Page) ([]SFSShare, string, error)
+
+	// ListSFSAccessRules returns the access rules of the SFS share
+	ListSFSAccessRules(ctx context.Context, shareID string) ([]SFSAccessRule, error)
+
+	// ListIdentityRoles returns a page of the IAM custom
+	// policies, IAM is a global service so they are the
+	// same independently of the region
+	ListIdentityRoles(ctx context.Context, page Page) ([]IdentityRole, string, error)
+
+	// ListProjects returns the IAM projects the credentials
+	// can access, of all the regions
+	ListProjects(ctx context.Context) ([]Project, error)
+
+	// ListDBSSInstances returns all the Database
+	// Security Service audit instances of the region
+	ListDBSSInstances(ctx context.Context) ([]DBSSInstance, error)
+
+	// ListDWSClusters returns all the Data
+	// Warehouse Service clusters of the region
+	ListDWSClusters(ctx context.Context) ([]DWSCluster, error)
+
+	// ListModelArtsNotebooks returns a page of
+	// the ModelArts notebooks of the region
+	ListModelArtsNotebooks(ctx context.Context, page Page) ([]ModelArtsNotebook, string, error)
+
+	// ListCFWFirewalls returns a page of the
+	// Cloud Firewall instances of the region
+	ListCFWFirewalls(ctx context.Context, page Page) ([]CFWFirewall, string, error)
+
+	// ListCFWProtectionRules returns a page of the protection
+	// rules of the CFW protected object objectID
+	ListCFWProtectionRules(ctx context.Context, objectID string, page Page) ([]CFWProtectionRule, string, error)
+
+	// ListEGEventChannels returns a page of the
+	// EventGrid custom event channels of the region
+	ListEGEventChannels(ctx context.Context, page Page) ([]EGEventChannel, string, error)
+
+	// ListEGEventSubscriptions returns a page of the
+	// subscriptions of the EventGrid channel channelID
+	ListEGEventSubscriptions(ctx context.Context, channelID string, page Page) ([]EGEventSubscription, string, error)
+
+	// ListWorkspaceDesktops returns a page of the
+	// Workspace cloud desktops of the region
+	ListWorkspaceDesktops(ctx context.Context, page Page) ([]WorkspaceDesktop, string, error)
+
+	// ListEnterpriseProjects returns a page of the
+	// enabled enterprise projects the caller can see
+	ListEnterpriseProjects(ctx context.Context, page Page) ([]EnterpriseProject, string, error)
+
+	// ListVPCs returns a page of the VPCs of the region, with
+	// their secondary CIDRs
+	ListVPCs(ctx context.Context, page Page) ([]VPC, string, error)
+
+	// ListSubnets returns a page of the VPC subnets of the region
+	ListSubnets(ctx context.Context, page Page) ([]Subnet, string, error)
+
+	// ListEIPs returns a page of the Elastic IPs of the region
+	ListEIPs(ctx context.Context, page Page) ([]EIP, string, error)
+
+	// ListPorts returns a page of the network ports of the region
+	ListPorts(ctx context.Context, page Page) ([]Port, string, error)
+
+	// ListSecurityGroups returns a page of the security groups of the region
+	ListSecurityGroups(ctx context.Context, page Page) ([]SecurityGroup, string, error)
+
+	// ListRouteTables returns a page of the VPC route tables of the region
+	ListRouteTables(ctx context.Context, page Page) ([]RouteTable, string, error)
+
+	// ListAntiDDoSStatuses returns a page of the EIPs protected
+	// by the Anti-DDoS basic service on the region
+	ListAntiDDoSStatuses(ctx context.Context, page Page) ([]AntiDDoSStatus, string, error)
+
+	// ListAADInstances returns all the Advanced Anti-DDoS instances
+	// of the account. AAD is a global service so the region is not used
+	ListAADInstances(ctx context.Context) ([]AADInstance, error)
+
+	// ListAADForwardRules returns all the forward rules of the ip
+	// protected by the AAD instance instanceID
+	ListAADForwardRules(ctx context.Context, instanceID, ip string) ([]AADForwardRule, error)
+
+	// ListELBCertificates returns a page of the certificates
+	// of the dedicated load balancers of the region
+	ListELBCertificates(ctx context.Context, page Page) ([]ELBCertificate, string, error)
+
+	// ListGESGraphs returns a page of the Graph
+	// Engine Service graphs of the region
+	ListGESGraphs(ctx context.Context, page Page) ([]GESGraph, string, error)
+
+	// ListDeHHosts returns a page of the Dedicated Hosts of the region
+	ListDeHHosts(ctx context.Context, page Page) ([]DeHHost, string, error)
+
+	// ListDMSRocketMQInstances returns a page of the
+	// DMS RocketMQ instances of the region
+	ListDMSRocketMQInstances(ctx context.Context, page Page) ([]DMSRocketMQInstance, string, error)
+
+	// ListDMSKafkaInstances returns a page of the
+	// DMS Kafka instances of the region
+	ListDMSKafkaInstances(ctx context.Context, page Page) ([]DMSKafkaInstance, string, error)
+
+	// ListDMSKafkaTopics returns a page of the
+	// topics of the DMS Kafka instance instanceID
+	ListDMSKafkaTopics(ctx context.Context, instanceID string, page Page) ([]DMSKafkaTopic, string, error)
+
+	// ListDMSKafkaConsumerGroups returns a page of the consumer
+	// groups of the DMS Kafka instance instanceID
+	ListDMSKafkaConsumerGroups(ctx context.Context, instanceID string, page Page) ([]DMSKafkaConsumerGroup, string, error)
+
+	// ListAOMAlarmRules returns a page of the AOM
+	// threshold alarm rules of the region
+	ListAOMAlarmRules(ctx context.Context, page Page) ([]AOMAlarmRule, string, error)
+
+	// ListDLIDatabases returns a page of the
+	// DLI databases of the project
+	ListDLIDatabases(ctx context.Context, page Page) ([]DLIDatabase, string, error)
+
+	// ListDLITables returns a page of the
+	// tables of the DLI database
+	ListDLITables(ctx context.Context, database string, page Page) ([]DLITable, string, error)
+
+	// ListIoTDAProducts returns a page of the
+	// IoTDA products of the region
+	ListIoTDAProducts(ctx context.Context, page Page) ([]IoTDAProduct, string, error)
+
+	// ListIoTDADevices returns a page of the
+	// IoTDA devices of the product productID
+	ListIoTDADevices(ctx context.Context, productID string, page Page) ([]IoTDADevice, string, error)
+
+	// ListELBIPGroups returns a page of the IP address
+	// groups of the dedicated load balancers of the region
+	ListELBIPGroups(ctx context.Context, page Page) ([]ELBIPGroup, string, error)
+
+	// ListELBPools returns a page of the backend server
+	// groups of the dedicated load balancers of the region
+	ListELBPools(ctx context.Context, page Page) ([]ELBPool, string, error)
+
+	// ListELBListeners returns a page of the listeners
+	// of the dedicated load balancers of the region
+	ListELBListeners(ctx context.Context, page Page) ([]ELBListener, string, error)
+
+	// ListELBL7Policies returns a page of the forwarding
+	// policies of the ELB listener listenerID
+	ListELBL7Policies(ctx context.Context, listenerID string, page Page) ([]ELBL7Policy, string, error)
+
+	// ListELBL7Rules returns a page of the forwarding
+	// rules of the ELB forwarding policy policyID
+	ListELBL7Rules(ctx context.Context, policyID string, page Page) ([]ELBL7Rule, string, error)
+
+	// ListELBSecurityPolicies returns a page of the custom TLS
+	// security policies of the dedicated load balancers of the region
+	ListELBSecurityPolicies(ctx context.Context, page Page) ([]ELBSecurityPolicy, string, error)
+
+	// ListCPHServers returns a page of the
+	// Cloud Phone servers of the region
+	ListCPHServers(ctx context.Context, page Page) ([]CPHServer, string, error)
+
+	// ListVPCEPEndpoints returns a page of the
+	// VPC endpoints of the region
+	ListVPCEPEndpoints(ctx context.Context, page Page) ([]VPCEPEndpoint, string, error)
+
+	// ListIMSPrivateImages returns a page of the
+	// private IMS images of the region
+	ListIMSPrivateImages(ctx context.Context, page Page) ([]IMSImage, string, error)
+
+	// ListIMSImageMembers returns the projects the
+	// IMS image imageID is shared with
+	ListIMSImageMembers(ctx context.Context, imageID string) ([]IMSImageMember, error)
+
+	// ListSecMasterWorkspaces returns a page of the SecMaster
+	// workspaces of the project, of any of the regions
+	ListSecMasterWorkspaces(ctx context.Context, page Page) ([]SecMasterWorkspace, string, error)
+
+	// ListRMSResources returns a page of the resources of the region
+	// tracked by the Resource Management Service, of all the services
+	ListRMSResources(ctx context.Context, page Page) ([]RMSResource, string, error)
+
+	// RunRMSQuery runs the ResourceQL advanced query expression
+	// of the Resource Management Service and returns all its rows
+	RunRMSQuery(ctx context.Context, expression string) (RMSQueryResult, error)
+}
+
+// Page holds the pagination options of a List call
+type Page struct {
+	// Limit is the maximum number of items per page,
+	// if 0 the DefaultLimit is used
+	Limit int
+
+	// Marker is the value returned by the previous call
+	Marker string
+
+	// EnterpriseProjectID, if defined, lists only the items of the
+	// enterprise project, it's only supported by some of the calls
+	EnterpriseProjectID string
+}
+
+// DefaultLimit is the page size used when the
+// Page.Limit is not defined
+const DefaultLimit = 100
+
+func (p Page) limit() int {
+	if p.Limit <= 0 {
+		return DefaultLimit
+	}
+	return p.Limit
+}
+
+// ConfigFunc returns the configuration of the Huawei Cloud Terraform
+// provider, which holds the credentials and the endpoints used
+// to build the API clients
+type ConfigFunc func(ctx context.Context) (*config.Config, error)
+
+type reader struct {
+	config ConfigFunc
+}
+
+// New returns a Reader which builds the API clients from
+// the configuration returned by fn
+func New(fn ConfigFunc) Reader {
+	return &reader{
+		config: fn,
+	}
+}
+
+// get does a GET to the path of the service srv and decodes the JSON
+// response on out. The path can have the '{project_id}' placeholder
+// which will be replaced with the project of the configured region,
+// and the '{domain_id}' one replaced with the account
+func (r *reader) get(ctx context.Context, srv, path string, query url.Values, out interface{}) error {
+	client, u, err := r.url(ctx, srv, path, query)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Get(u, out, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to GET %q", u)
+	}
+
+	return nil
+}
+
+// post does a POST with the JSON body to the path of the service srv,
+// used by the APIs that list with a POST, and decodes the JSON response
+// on out. The path works the same way as on get
+func (r *reader) post(ctx context.Context, srv, path string, body, out interface{}) error {
+	client, u, err := r.url(ctx, srv, path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(u, body, out, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		OkCodes:     []int{200},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to POST %q", u)
+	}
+
+	return nil
+}
+
+// url returns the client of the service srv and the URL of the path
+func (r *reader) url(ctx context.Context, srv, path string, query url.Values) (*golangsdk.ServiceClient, string, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := conf.NewServiceClient(srv, conf.Region)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "unable to create the %q client", srv)
+	}
+
+	u := client.Endpoint + strings.NewReplacer(
+		"{project_id}", client.ProjectID,
+		"{domain_id}", conf.DomainID,
+	).Replace(path)
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+
+	return client, u, nil
+}
@@ -0,0 +1,85 @@
+package reader
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// RMSResource is a resource tracked by the Resource Management
+// Service, the Provider and Type identify the kind of resource
+// (ex: 'vpc' and 'vpcs') independently of the service API
+type RMSResource struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Provider            string `json:"provider"`
+	Type                string `json:"type"`
+	RegionID            string `json:"region_id"`
+	EnterpriseProjectID string `json:"ep_id"`
+}
+
+// rmsMaxLimit is the maximum page size of the RMS
+const rmsMaxLimit = 200
+
+func (r *reader) ListRMSResources(ctx context.Context, page Page) ([]RMSResource, string, error) {
+	conf, err := r.config(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := page.limit()
+	if limit > rmsMaxLimit {
+		limit = rmsMaxLimit
+	}
+
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("region_id", conf.Region)
+	if page.Marker != "" {
+		q.Set("marker", page.Marker)
+	}
+	if page.EnterpriseProjectID != "" {
+		q.Set("ep_id", page.EnterpriseProjectID)
+	}
+
+	var body struct {
+		Resources []RMSResource `json:"resources"`
+		PageInfo  struct {
+			NextMarker string `json:"next_marker"`
+		} `json:"page_info"`
+	}
+
+	err = r.get(ctx, "rms", "v1/resource-manager/domains/{domain_id}/all-resources", q, &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Resources, body.PageInfo.NextMarker, nil
+}
+
+// RMSQueryResult is the result of a ResourceQL query of the RMS, each
+// one of the Results has the SelectFields of a row, by field name
+type RMSQueryResult struct {
+	SelectFields []string
+	Results      []map[string]interface{}
+}
+
+func (r *reader) RunRMSQuery(ctx context.Context, expression string) (RMSQueryResult, error) {
+	var body struct {
+		QueryInfo struct {
+			SelectFields []string `json:"select_fields"`
+		} `json:"query_info"`
+		Results []map[string]interface{} `json:"results"`
+	}
+
+	req := map[string]string{"expression": expression}
+	err := r.post(ctx, "rms", "v1/resource-manager/domains/{domain_id}/run-query", req, &body)
+	if err != nil {
+		return RMSQueryResult{}, err
+	}
+
+	return RMSQueryResult{
+		SelectFields: body.QueryInfo.SelectFields,
+		Results:      body.Results,
+	}, nil
+}
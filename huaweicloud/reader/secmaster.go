@@ -0,0 +1,27 @@
+package reader
+
+import "context"
+
+// SecMasterWorkspace is a workspace of SecMaster (formerly Situation
+// Awareness), the RegionID is the region it belongs to and the views
+// (IsView) aggregate the workspaces of other regions or projects
+type SecMasterWorkspace struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	RegionID  string `json:"region_id"`
+	ProjectID string `json:"project_id"`
+	IsView    bool   `json:"is_view"`
+}
+
+func (r *reader) ListSecMasterWorkspaces(ctx context.Context, page Page) ([]SecMasterWorkspace, string, error) {
+	var body struct {
+		Workspaces []SecMasterWorkspace `json:"workspaces"`
+	}
+
+	err := r.get(ctx, "secmaster", "v1/{project_id}/workspaces", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Workspaces, nextOffset(page, len(body.Workspaces)), nil
+}
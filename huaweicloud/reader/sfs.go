@@ -0,0 +1,60 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+)
+
+// SFSShare is a file system of the classic Scalable File Service,
+// the SFS Turbo file systems are on a different API
+type SFSShare struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"created_at"`
+	ShareProto       string `json:"share_proto"`
+	Size             int    `json:"size"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+// SFSAccessRule is an access rule of an SFSShare, for the
+// 'cert' AccessType the AccessTo is the ID of the VPC
+type SFSAccessRule struct {
+	ID          string `json:"id"`
+	AccessType  string `json:"access_type"`
+	AccessTo    string `json:"access_to"`
+	AccessLevel string `json:"access_level"`
+	State       string `json:"state"`
+}
+
+// SFSAccessTypeVPC is the SFSAccessRule.AccessType
+// of the rules that give access to a VPC
+const SFSAccessTypeVPC = "cert"
+
+func (r *reader) ListSFSShares(ctx context.Context, page Page) ([]SFSShare, string, error) {
+	var body struct {
+		Shares []SFSShare `json:"shares"`
+	}
+
+	err := r.get(ctx, "sfs", "v2/{project_id}/shares/detail", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Shares, nextOffset(page, len(body.Shares)), nil
+}
+
+func (r *reader) ListSFSAccessRules(ctx context.Context, shareID string) ([]SFSAccessRule, error) {
+	var body struct {
+		AccessRules []SFSAccessRule `json:"access_list"`
+	}
+
+	req := map[string]interface{}{"os-access_list": nil}
+
+	err := r.post(ctx, "sfs", fmt.Sprintf("v2/{project_id}/shares/%s/action", shareID), req, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.AccessRules, nil
+}
@@ -0,0 +1,23 @@
+package reader
+
+import "context"
+
+// SMNTopic is a topic of the Simple Message Notification service
+type SMNTopic struct {
+	URN         string `json:"topic_urn"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+func (r *reader) ListSMNTopics(ctx context.Context, page Page) ([]SMNTopic, string, error) {
+	var body struct {
+		Topics []SMNTopic `json:"topics"`
+	}
+
+	err := r.get(ctx, "smn", "v2/{project_id}/notifications/topics", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Topics, nextOffset(page, len(body.Topics)), nil
+}
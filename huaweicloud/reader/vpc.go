@@ -0,0 +1,257 @@
+package reader
+
+import (
+	"context"
+	"net/url"
+)
+
+// VPC is a Virtual Private Cloud
+type VPC struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	CIDR                string `json:"cidr"`
+	Status              string `json:"status"`
+	CreatedAt           string `json:"created_at"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+
+	// SecondaryCIDRs are the extended CIDRs of the VPC, which
+	// are only returned by the v3 API
+	SecondaryCIDRs []string `json:"-"`
+}
+
+func (r *reader) ListVPCs(ctx context.Context, page Page) ([]VPC, string, error) {
+	var body struct {
+		VPCs []VPC `json:"vpcs"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/vpcs", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.VPCs); n != 0 {
+		last = body.VPCs[n-1].ID
+
+		err = r.setVPCSecondaryCIDRs(ctx, body.VPCs)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return body.VPCs, nextMarker(page, len(body.VPCs), last), nil
+}
+
+// setVPCSecondaryCIDRs sets the SecondaryCIDRs of the vpcs with
+// one call to the v3 API filtered by their IDs, as the v1 one
+// does not return them
+func (r *reader) setVPCSecondaryCIDRs(ctx context.Context, vpcs []VPC) error {
+	var body struct {
+		VPCs []struct {
+			ID          string   `json:"id"`
+			ExtendCIDRs []string `json:"extend_cidrs"`
+		} `json:"vpcs"`
+	}
+
+	q := url.Values{}
+	for _, v := range vpcs {
+		q.Add("id", v.ID)
+	}
+
+	err := r.get(ctx, "vpc", "v3/{project_id}/vpc/vpcs", q, &body)
+	if err != nil {
+		return err
+	}
+
+	cidrs := make(map[string][]string, len(body.VPCs))
+	for _, v := range body.VPCs {
+		cidrs[v.ID] = v.ExtendCIDRs
+	}
+
+	for i := range vpcs {
+		vpcs[i].SecondaryCIDRs = cidrs[vpcs[i].ID]
+	}
+
+	return nil
+}
+
+// Subnet is a subnet of a VPC
+type Subnet struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CIDR      string `json:"cidr"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	VpcID     string `json:"vpc_id"`
+	GatewayIP string `json:"gateway_ip"`
+
+	// DHCPEnable is false on the subnets which
+	// IPs are only assigned manually (static)
+	DHCPEnable bool `json:"dhcp_enable"`
+
+	// The DNS servers and the lease time (ex: '24h') of the
+	// DHCP, the DNSList has all the servers when more than two
+	PrimaryDNS    string   `json:"primary_dns"`
+	SecondaryDNS  string   `json:"secondary_dns"`
+	DNSList       []string `json:"dnsList"`
+	DHCPLeaseTime string   `json:"dhcp_lease_time"`
+
+	// The IPv6 fields are only set on the subnets
+	// with IPv6 enabled (dual-stack)
+	IPv6Enable   bool   `json:"ipv6_enable"`
+	IPv6CIDR     string `json:"cidr_v6"`
+	IPv6Gateway  string `json:"gateway_ip_v6"`
+	IPv6SubnetID string `json:"neutron_subnet_id_v6"`
+}
+
+func (r *reader) ListSubnets(ctx context.Context, page Page) ([]Subnet, string, error) {
+	var body struct {
+		Subnets []Subnet `json:"subnets"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/subnets", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Subnets); n != 0 {
+		last = body.Subnets[n-1].ID
+	}
+
+	return body.Subnets, nextMarker(page, len(body.Subnets), last), nil
+}
+
+// EIP is an Elastic IP of the VPC service
+type EIP struct {
+	ID                  string `json:"id"`
+	Alias               string `json:"alias"`
+	Status              string `json:"status"`
+	CreateTime          string `json:"create_time"`
+	Type                string `json:"type"`
+	PublicIPAddress     string `json:"public_ip_address"`
+	PrivateIPAddress    string `json:"private_ip_address"`
+	PortID              string `json:"port_id"`
+	BandwidthID         string `json:"bandwidth_id"`
+	BandwidthName       string `json:"bandwidth_name"`
+	BandwidthShareType  string `json:"bandwidth_share_type"`
+	BandwidthSize       int    `json:"bandwidth_size"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+
+	// Profile has the order of the
+	// yearly/monthly (prePaid) EIPs
+	Profile EIPProfile `json:"profile"`
+}
+
+// EIPProfile is the billing information of an EIP
+type EIPProfile struct {
+	OrderID string `json:"order_id"`
+}
+
+func (r *reader) ListEIPs(ctx context.Context, page Page) ([]EIP, string, error) {
+	var body struct {
+		PublicIPs []EIP `json:"publicips"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/publicips", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.PublicIPs); n != 0 {
+		last = body.PublicIPs[n-1].ID
+	}
+
+	return body.PublicIPs, nextMarker(page, len(body.PublicIPs), last), nil
+}
+
+// SecurityGroup is a security group of the VPC service
+type SecurityGroup struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	VpcID               string `json:"vpc_id"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+}
+
+func (r *reader) ListSecurityGroups(ctx context.Context, page Page) ([]SecurityGroup, string, error) {
+	var body struct {
+		SecurityGroups []SecurityGroup `json:"security_groups"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/security-groups", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.SecurityGroups); n != 0 {
+		last = body.SecurityGroups[n-1].ID
+	}
+
+	return body.SecurityGroups, nextMarker(page, len(body.SecurityGroups), last), nil
+}
+
+// RouteTable is a route table of a VPC
+type RouteTable struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Default     bool   `json:"default"`
+	VpcID       string `json:"vpc_id"`
+	Description string `json:"description"`
+}
+
+func (r *reader) ListRouteTables(ctx context.Context, page Page) ([]RouteTable, string, error) {
+	var body struct {
+		RouteTables []RouteTable `json:"routetables"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/routetables", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.RouteTables); n != 0 {
+		last = body.RouteTables[n-1].ID
+	}
+
+	return body.RouteTables, nextMarker(page, len(body.RouteTables), last), nil
+}
+
+// Port is a network port of a VPC subnet, the DeviceID
+// is the ID of the ECS instance for the 'compute:' DeviceOwner
+type Port struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Status      string        `json:"status"`
+	NetworkID   string        `json:"network_id"`
+	DeviceID    string        `json:"device_id"`
+	DeviceOwner string        `json:"device_owner"`
+	FixedIPs    []PortFixedIP `json:"fixed_ips"`
+}
+
+// PortFixedIP is a private IP of a Port
+type PortFixedIP struct {
+	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address"`
+}
+
+func (r *reader) ListPorts(ctx context.Context, page Page) ([]Port, string, error) {
+	var body struct {
+		Ports []Port `json:"ports"`
+	}
+
+	err := r.get(ctx, "vpc", "v1/{project_id}/ports", markerQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var last string
+	if n := len(body.Ports); n != 0 {
+		last = body.Ports[n-1].ID
+	}
+
+	return body.Ports, nextMarker(page, len(body.Ports), last), nil
+}
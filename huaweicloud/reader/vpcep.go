@@ -0,0 +1,27 @@
+package reader
+
+import "context"
+
+// VPCEPEndpoint is a VPC endpoint, the DNSNames are the private
+// domain names of the endpoint when it has EnableDNS
+type VPCEPEndpoint struct {
+	ID        string   `json:"id"`
+	Status    string   `json:"status"`
+	CreatedAt string   `json:"created_at"`
+	VPCID     string   `json:"vpc_id"`
+	EnableDNS bool     `json:"enable_dns"`
+	DNSNames  []string `json:"dns_names"`
+}
+
+func (r *reader) ListVPCEPEndpoints(ctx context.Context, page Page) ([]VPCEPEndpoint, string, error) {
+	var body struct {
+		Endpoints []VPCEPEndpoint `json:"endpoints"`
+	}
+
+	err := r.get(ctx, "vpcep", "v1/{project_id}/vpc-endpoints", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Endpoints, nextOffset(page, len(body.Endpoints)), nil
+}
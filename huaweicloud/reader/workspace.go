@@ -0,0 +1,40 @@
+package reader
+
+import "context"
+
+// WorkspaceDesktop is a cloud desktop of the Workspace service,
+// the Addresses are indexed by the ID of the network (subnet)
+type WorkspaceDesktop struct {
+	ID                  string                               `json:"desktop_id"`
+	Name                string                               `json:"computer_name"`
+	Status              string                               `json:"status"`
+	TaskStatus          string                               `json:"task_status"`
+	UserName            string                               `json:"user_name"`
+	ProductID           string                               `json:"product_id"`
+	Addresses           map[string][]WorkspaceDesktopAddress `json:"addresses"`
+	SecurityGroups      []WorkspaceDesktopSecurityGroup      `json:"security_groups"`
+	EnterpriseProjectID string                               `json:"enterprise_project_id"`
+}
+
+// WorkspaceDesktopAddress is an IP address of a WorkspaceDesktop
+type WorkspaceDesktopAddress struct {
+	Address string `json:"addr"`
+}
+
+// WorkspaceDesktopSecurityGroup is a security group of a WorkspaceDesktop
+type WorkspaceDesktopSecurityGroup struct {
+	ID string `json:"id"`
+}
+
+func (r *reader) ListWorkspaceDesktops(ctx context.Context, page Page) ([]WorkspaceDesktop, string, error) {
+	var body struct {
+		Desktops []WorkspaceDesktop `json:"desktops"`
+	}
+
+	err := r.get(ctx, "workspace", "v2/{project_id}/desktops/detail", offsetQuery(page), &body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Desktops, nextOffset(page, len(body.Desktops)), nil
+}
@@ -0,0 +1,93 @@
+package huaweicloud
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// discoveryRegion is the region of the IAM endpoint used to discover the
+// regions if none is given, as IAM is global any region lists all of them
+const discoveryRegion = "cn-north-4"
+
+// Region is a region the credentials can access, with the ID of its project
+type Region struct {
+	Name      string
+	ProjectID string
+}
+
+// DiscoverRegions returns the regions the credentials can access, sorted by
+// name, from the IAM projects of the account. The IAM endpoint used is the
+// one of the region or, if empty, of the first region of the Options.Catalog
+// or of 'cn-north-4'. With the Options.Catalog only its regions are returned
+func DiscoverRegions(ctx context.Context, region, accessKey, secretKey, securityToken string, opts Options) ([]Region, error) {
+	if region == "" {
+		region = opts.discoveryRegion()
+	}
+
+	p, err := NewProvider(ctx, region, "", accessKey, secretKey, securityToken, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return discoverRegions(ctx, p.(*huaweicloudProvider))
+}
+
+// discoverRegions returns the regions of the enabled IAM projects,
+// which are not subprojects nor built-in projects
+func discoverRegions(ctx context.Context, p *huaweicloudProvider) ([]Region, error) {
+	projects, err := p.reader.ListProjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list IAM projects")
+	}
+
+	regions := make([]Region, 0, len(projects))
+	for _, pr := range projects {
+		if !pr.Enabled {
+			continue
+		}
+
+		// The catalog has the regions of the cloud,
+		// which may not be named as the public ones
+		if p.options.Catalog != nil {
+			if _, ok := p.options.Catalog.Regions[pr.Name]; !ok {
+				continue
+			}
+		} else if !isRegionProject(pr.Name) {
+			continue
+		}
+
+		regions = append(regions, Region{Name: pr.Name, ProjectID: pr.ID})
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Name < regions[j].Name
+	})
+
+	return regions, nil
+}
+
+// isRegionProject checks if the IAM project name is the one of a region
+// (ex: 'cn-north-4'), the subprojects are named 'REGION_NAME' and the
+// built-in projects (ex: 'MOS') have no '-'
+func isRegionProject(name string) bool {
+	return strings.Contains(name, "-") && !strings.Contains(name, "_")
+}
+
+// discoveryRegion returns the region used to discover the
+// regions, the first one of the Catalog if defined
+func (o Options) discoveryRegion() string {
+	if o.Catalog == nil || len(o.Catalog.Regions) == 0 {
+		return discoveryRegion
+	}
+
+	names := make([]string, 0, len(o.Catalog.Regions))
+	for n := range o.Catalog.Regions {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	return names[0]
+}
@@ -0,0 +1,82 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverRegions(t *testing.T) {
+	projects := []reader.Project{
+		{ID: "p-north-4", Name: "cn-north-4", Enabled: true},
+		{ID: "p-sub", Name: "cn-north-4_dev", Enabled: true},
+		{ID: "p-mos", Name: "MOS", Enabled: true},
+		{ID: "p-disabled", Name: "af-south-1", Enabled: false},
+		{ID: "p-ap-1", Name: "ap-southeast-1", Enabled: true},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListProjects(ctx).Return(projects, nil)
+
+		regions, err := discoverRegions(ctx, p)
+		require.NoError(t, err)
+		assert.Equal(t, []Region{
+			{Name: "ap-southeast-1", ProjectID: "p-ap-1"},
+			{Name: "cn-north-4", ProjectID: "p-north-4"},
+		}, regions)
+
+		// The discovered regions are the
+		// ones the providers are created with
+		for _, rg := range regions {
+			rp, err := NewProvider(ctx, rg.Name, rg.ProjectID, "access", "secret", "", Options{})
+			require.NoError(t, err)
+
+			cfg := rp.(*huaweicloudProvider).tfClient.(map[string]interface{})
+			assert.Equal(t, rg.Name, cfg["region"])
+			assert.Equal(t, rg.ProjectID, cfg["project_id"])
+		}
+	})
+
+	t.Run("SuccessWithCatalog", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.Catalog = &Catalog{Regions: map[string]RegionCatalog{
+			"cn-north-4": {Cloud: "hcs.example.com"},
+			"hcs1":       {Cloud: "hcs.example.com"},
+		}}
+
+		r.EXPECT().ListProjects(ctx).Return(append(projects, reader.Project{ID: "p-hcs1", Name: "hcs1", Enabled: true}), nil)
+
+		regions, err := discoverRegions(ctx, p)
+		require.NoError(t, err)
+		assert.Equal(t, []Region{
+			{Name: "cn-north-4", ProjectID: "p-north-4"},
+			{Name: "hcs1", ProjectID: "p-hcs1"},
+		}, regions)
+
+		assert.Equal(t, "cn-north-4", p.options.discoveryRegion())
+	})
+
+	t.Run("DiscoveryRegion", func(t *testing.T) {
+		assert.Equal(t, discoveryRegion, Options{}.discoveryRegion())
+	})
+}
@@ -0,0 +1,47 @@
+package huaweicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	tfhuaweicloud "github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/pkg/errors"
+)
+
+// ResourceReader reads the resources of a resource type registered
+// with the RegisterResource, p is the Huawei Cloud Provider reading
+// them which is the one the provider.Resource have to be created with
+type ResourceReader func(ctx context.Context, p provider.Provider, resourceType string, f *filter.Filter) ([]provider.Resource, error)
+
+// RegisterResource registers the reader rr of the resource type rt, so
+// forks or plugins can import the types of the TF provider that are not
+// implemented without changing the resources. The rt is then one of the
+// ResourceTypeStrings. It has to be called before creating the Providers
+// (ex: on an 'init'), the rt can not be an already registered type and
+// it has to be on the embedded TF provider to generate its HCL and state
+func RegisterResource(rt ResourceType, rr ResourceReader) error {
+	if !strings.HasPrefix(string(rt), resourceTypePrefix) || string(rt) == resourceTypePrefix {
+		return errors.Errorf("invalid resource type %q, it has to start with %q", rt, resourceTypePrefix)
+	}
+	if rr == nil {
+		return errors.Errorf("invalid resource type %q, it has no reader", rt)
+	}
+	if _, ok := resources[rt]; ok {
+		return errors.Errorf("the resource type %q is already registered", rt)
+	}
+	if art, ok := resourceTypeAliases[strings.TrimPrefix(string(rt), resourceTypePrefix)]; ok {
+		return errors.Errorf("the short name of the resource type %q is an alias of %q", rt, art)
+	}
+	if _, ok := tfhuaweicloud.Provider().ResourcesMap[string(rt)]; !ok {
+		return errors.Errorf("the resource type %q is not on the TF Provider %s", rt, version)
+	}
+
+	resources[rt] = func(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+		return rr(ctx, p, resourceType, f)
+	}
+	resourceTypeValues = append(resourceTypeValues, rt)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterResource(t *testing.T) {
+	const custom ResourceType = "huaweicloud_fgs_function"
+
+	// The registry is restored so the
+	// other tests have the default types
+	values := append([]ResourceType(nil), resourceTypeValues...)
+	defer func() {
+		delete(resources, custom)
+		resourceTypeValues = values
+	}()
+
+	err := RegisterResource(custom, func(ctx context.Context, p provider.Provider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+		return []provider.Resource{
+			provider.NewResource("fn-1", resourceType, p),
+			provider.NewResource("fn-2", resourceType, p),
+		}, nil
+	})
+	require.NoError(t, err)
+
+	t.Run("ResourceTypes", func(t *testing.T) {
+		assert.Contains(t, ResourceTypeStrings(), string(custom))
+
+		rt, err := ResolveResourceType("fgs_function")
+		require.NoError(t, err)
+		assert.Equal(t, custom, rt)
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		p.options.TypeFilters = map[string]TypeFilter{string(custom): {Max: 1}}
+
+		rs, err := p.Resources(context.Background(), string(custom), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "fn-1", rs[0].ID())
+		assert.Equal(t, string(custom), rs[0].Type())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		noop := func(context.Context, provider.Provider, string, *filter.Filter) ([]provider.Resource, error) {
+			return nil, nil
+		}
+
+		tcs := []struct {
+			Name string
+			RT   ResourceType
+			RR   ResourceReader
+			Err  string
+		}{
+			{Name: "Registered", RT: custom, RR: noop, Err: `the resource type "huaweicloud_fgs_function" is already registered`},
+			{Name: "Implemented", RT: VPC, RR: noop, Err: `the resource type "huaweicloud_vpc" is already registered`},
+			{Name: "Prefix", RT: "fgs_trigger", RR: noop, Err: `invalid resource type "fgs_trigger", it has to start with "huaweicloud_"`},
+			{Name: "WithoutReader", RT: "huaweicloud_fgs_trigger", Err: `invalid resource type "huaweicloud_fgs_trigger", it has no reader`},
+			{Name: "Alias", RT: "huaweicloud_ecs", RR: noop, Err: `the short name of the resource type "huaweicloud_ecs" is an alias of "huaweicloud_compute_instance"`},
+			{Name: "NotOnTFProvider", RT: "huaweicloud_not_a_resource", RR: noop, Err: `the resource type "huaweicloud_not_a_resource" is not on the TF Provider ` + version},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.Name, func(t *testing.T) {
+				err := RegisterResource(tc.RT, tc.RR)
+				require.Error(t, err)
+				assert.Equal(t, tc.Err, err.Error())
+			})
+		}
+	})
+}
@@ -6,13 +6,63 @@ import "fmt"
 type ResourceType string
 
 const (
-	ComputeInstance ResourceType = "huaweicloud_compute_instance"
-	VPC             ResourceType = "huaweicloud_vpc"
-	VPCSubnet       ResourceType = "huaweicloud_vpc_subnet"
-	EIP             ResourceType = "huaweicloud_vpc_eip"
-	EVSVolume       ResourceType = "huaweicloud_evs_volume"
-	NatGateway      ResourceType = "huaweicloud_nat_gateway"
-	OBSBucket       ResourceType = "huaweicloud_obs_bucket"
+	ComputeInstance       ResourceType = "huaweicloud_compute_instance"
+	VPC                   ResourceType = "huaweicloud_vpc"
+	VPCSubnet             ResourceType = "huaweicloud_vpc_subnet"
+	EIP                   ResourceType = "huaweicloud_vpc_eip"
+	EIPAssociate          ResourceType = "huaweicloud_vpc_eip_associate"
+	VPCRouteTable         ResourceType = "huaweicloud_vpc_route_table"
+	SecurityGroup         ResourceType = "huaweicloud_networking_secgroup"
+	EVSVolume             ResourceType = "huaweicloud_evs_volume"
+	NatGateway            ResourceType = "huaweicloud_nat_gateway"
+	OBSBucket             ResourceType = "huaweicloud_obs_bucket"
+	SFSFileSystem         ResourceType = "huaweicloud_sfs_file_system"
+	SFSAccessRule         ResourceType = "huaweicloud_sfs_access_rule"
+	AntiDDoSBasic         ResourceType = "huaweicloud_antiddos_basic"
+	AADForwardRule        ResourceType = "huaweicloud_aad_forward_rule"
+	RDSInstance           ResourceType = "huaweicloud_rds_instance"
+	GaussDBOpenGauss      ResourceType = "huaweicloud_gaussdb_opengauss_instance"
+	DRSJob                ResourceType = "huaweicloud_drs_job"
+	ASGroup               ResourceType = "huaweicloud_as_group"
+	ASPolicy              ResourceType = "huaweicloud_as_policy"
+	ASLifecycleHook       ResourceType = "huaweicloud_as_lifecycle_hook"
+	SMNTopic              ResourceType = "huaweicloud_smn_topic"
+	CESAlarmRule          ResourceType = "huaweicloud_ces_alarmrule"
+	WorkspaceDesktop      ResourceType = "huaweicloud_workspace_desktop"
+	IdentityRole          ResourceType = "huaweicloud_identity_role"
+	DBSSInstance          ResourceType = "huaweicloud_dbss_instance"
+	ELBCertificate        ResourceType = "huaweicloud_elb_certificate"
+	GESGraph              ResourceType = "huaweicloud_ges_graph"
+	DeHHost               ResourceType = "huaweicloud_deh_instance"
+	OBSBucketACL          ResourceType = "huaweicloud_obs_bucket_acl"
+	DMSRocketMQ           ResourceType = "huaweicloud_dms_rocketmq_instance"
+	DWSCluster            ResourceType = "huaweicloud_dws_cluster"
+	ModelArtsNotebook     ResourceType = "huaweicloud_modelarts_notebook"
+	RDSReadReplica        ResourceType = "huaweicloud_rds_read_replica_instance"
+	CFWInstance           ResourceType = "huaweicloud_cfw_firewall"
+	CFWRule               ResourceType = "huaweicloud_cfw_protection_rule"
+	EGEventChannel        ResourceType = "huaweicloud_eg_custom_event_channel"
+	EGEventSubscription   ResourceType = "huaweicloud_eg_event_subscription"
+	DMSKafka              ResourceType = "huaweicloud_dms_kafka_instance"
+	DMSKafkaTopic         ResourceType = "huaweicloud_dms_kafka_topic"
+	AOMAlarmRule          ResourceType = "huaweicloud_aom_alarm_rule"
+	DLIDatabase           ResourceType = "huaweicloud_dli_database"
+	DLITable              ResourceType = "huaweicloud_dli_table"
+	IoTDAProduct          ResourceType = "huaweicloud_iotda_product"
+	IoTDADevice           ResourceType = "huaweicloud_iotda_device"
+	ELBIpGroup            ResourceType = "huaweicloud_elb_ipgroup"
+	CPHServer             ResourceType = "huaweicloud_cph_server"
+	ELBMonitor            ResourceType = "huaweicloud_elb_monitor"
+	VPCEPEndpoint         ResourceType = "huaweicloud_vpcep_endpoint"
+	IMSImageShare         ResourceType = "huaweicloud_images_image_share"
+	SecMasterWorkspace    ResourceType = "huaweicloud_secmaster_workspace"
+	ELBSecurityPolicy     ResourceType = "huaweicloud_elb_security_policy"
+	ComputeVolumeAttach   ResourceType = "huaweicloud_compute_volume_attach"
+	DMSKafkaConsumerGroup ResourceType = "huaweicloud_dms_kafka_consumer_group"
+	RDSDatabase           ResourceType = "huaweicloud_rds_mysql_database"
+	RDSAccount            ResourceType = "huaweicloud_rds_mysql_account"
+	ELBL7Policy           ResourceType = "huaweicloud_elb_l7policy"
+	ELBL7Rule             ResourceType = "huaweicloud_elb_l7rule"
 )
 
 var resourceTypeValues = []ResourceType{
@@ -20,9 +70,59 @@ var resourceTypeValues = []ResourceType{
 	VPC,
 	VPCSubnet,
 	EIP,
+	EIPAssociate,
+	VPCRouteTable,
+	SecurityGroup,
 	EVSVolume,
 	NatGateway,
 	OBSBucket,
+	SFSFileSystem,
+	SFSAccessRule,
+	AntiDDoSBasic,
+	AADForwardRule,
+	RDSInstance,
+	GaussDBOpenGauss,
+	DRSJob,
+	ASGroup,
+	ASPolicy,
+	ASLifecycleHook,
+	SMNTopic,
+	CESAlarmRule,
+	WorkspaceDesktop,
+	IdentityRole,
+	DBSSInstance,
+	ELBCertificate,
+	GESGraph,
+	DeHHost,
+	OBSBucketACL,
+	DMSRocketMQ,
+	DWSCluster,
+	ModelArtsNotebook,
+	RDSReadReplica,
+	CFWInstance,
+	CFWRule,
+	EGEventChannel,
+	EGEventSubscription,
+	DMSKafka,
+	DMSKafkaTopic,
+	AOMAlarmRule,
+	DLIDatabase,
+	DLITable,
+	IoTDAProduct,
+	IoTDADevice,
+	ELBIpGroup,
+	CPHServer,
+	ELBMonitor,
+	VPCEPEndpoint,
+	IMSImageShare,
+	SecMasterWorkspace,
+	ELBSecurityPolicy,
+	ComputeVolumeAttach,
+	DMSKafkaConsumerGroup,
+	RDSDatabase,
+	RDSAccount,
+	ELBL7Policy,
+	ELBL7Rule,
 }
 
 // ResourceTypeStrings returns the list of resource type strings supported by the Huawei Cloud provider.
@@ -43,3 +143,95 @@ func ResourceTypeString(in string) (ResourceType, error) {
 	}
 	return "", fmt.Errorf("unsupported resource type %q", in)
 }
+
+// resourceTypePrefix is the prefix of all the resource types, the
+// short name of a type is the type without it (ex: 'vpc')
+const resourceTypePrefix = "huaweicloud_"
+
+// resourceTypeAliases are the aliases of the resource types with the
+// names of the services (ex: 'ecs') and of the equivalent resources
+// of AWS (ex: 's3_bucket'), they can not be a short name of a type
+var resourceTypeAliases = map[string]ResourceType{
+	"ecs":                        ComputeInstance,
+	"instance":                   ComputeInstance,
+	"ec2_instance":               ComputeInstance,
+	"subnet":                     VPCSubnet,
+	"eip":                        EIP,
+	"elastic_ip":                 EIP,
+	"eip_association":            EIPAssociate,
+	"route_table":                VPCRouteTable,
+	"security_group":             SecurityGroup,
+	"secgroup":                   SecurityGroup,
+	"sg":                         SecurityGroup,
+	"evs":                        EVSVolume,
+	"volume":                     EVSVolume,
+	"ebs_volume":                 EVSVolume,
+	"nat":                        NatGateway,
+	"obs":                        OBSBucket,
+	"bucket":                     OBSBucket,
+	"s3_bucket":                  OBSBucket,
+	"sfs":                        SFSFileSystem,
+	"efs_file_system":            SFSFileSystem,
+	"antiddos":                   AntiDDoSBasic,
+	"rds":                        RDSInstance,
+	"db_instance":                RDSInstance,
+	"rds_read_replica":           RDSReadReplica,
+	"read_replica":               RDSReadReplica,
+	"opengauss":                  GaussDBOpenGauss,
+	"drs":                        DRSJob,
+	"autoscaling_group":          ASGroup,
+	"autoscaling_policy":         ASPolicy,
+	"autoscaling_lifecycle_hook": ASLifecycleHook,
+	"smn":                        SMNTopic,
+	"sns_topic":                  SMNTopic,
+	"ces_alarm":                  CESAlarmRule,
+	"cloudwatch_metric_alarm":    CESAlarmRule,
+	"desktop":                    WorkspaceDesktop,
+	"dbss":                       DBSSInstance,
+	"ges":                        GESGraph,
+	"deh":                        DeHHost,
+	"dedicated_host":             DeHHost,
+	"rocketmq":                   DMSRocketMQ,
+	"dws":                        DWSCluster,
+	"redshift_cluster":           DWSCluster,
+	"notebook":                   ModelArtsNotebook,
+	"cfw":                        CFWInstance,
+	"firewall":                   CFWInstance,
+	"cfw_rule":                   CFWRule,
+	"eg_event_channel":           EGEventChannel,
+	"eg_subscription":            EGEventSubscription,
+	"kafka":                      DMSKafka,
+	"kafka_topic":                DMSKafkaTopic,
+	"aom_alarm":                  AOMAlarmRule,
+	"cloud_phone":                CPHServer,
+	"elb_health_check":           ELBMonitor,
+	"vpc_endpoint":               VPCEPEndpoint,
+	"ims_image_share":            IMSImageShare,
+	"situation_awareness":        SecMasterWorkspace,
+	"elb_tls_policy":             ELBSecurityPolicy,
+	"volume_attachment":          ComputeVolumeAttach,
+	"kafka_consumer_group":       DMSKafkaConsumerGroup,
+	"mysql_database":             RDSDatabase,
+	"mysql_account":              RDSAccount,
+	"lb_listener_rule":           ELBL7Policy,
+}
+
+// ResolveResourceType returns the resource type of in, which can be the
+// type (ex: 'huaweicloud_vpc'), its short name (ex: 'vpc') or an alias
+// of it (ex: 'ecs'), it fails as the ResourceTypeString if none matches
+func ResolveResourceType(in string) (ResourceType, error) {
+	rt, err := ResourceTypeString(in)
+	if err == nil {
+		return rt, nil
+	}
+
+	if srt, serr := ResourceTypeString(resourceTypePrefix + in); serr == nil {
+		return srt, nil
+	}
+
+	if art, ok := resourceTypeAliases[in]; ok {
+		return art, nil
+	}
+
+	return "", err
+}
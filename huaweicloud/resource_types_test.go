@@ -0,0 +1,52 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveResourceType(t *testing.T) {
+	tcs := []struct {
+		Name string
+		In   string
+		Type ResourceType
+	}{
+		{Name: "Type", In: "huaweicloud_vpc", Type: VPC},
+		{Name: "ShortName", In: "vpc", Type: VPC},
+		{Name: "ShortNameWithUnderscore", In: "compute_instance", Type: ComputeInstance},
+		{Name: "Alias", In: "ecs", Type: ComputeInstance},
+		{Name: "AWSAlias", In: "s3_bucket", Type: OBSBucket},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			rt, err := ResolveResourceType(tc.In)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Type, rt)
+		})
+	}
+
+	t.Run("ErrorUnknown", func(t *testing.T) {
+		for _, in := range []string{"huaweicloud_unknown", "unknown", "huaweicloud_ecs", ""} {
+			_, err := ResolveResourceType(in)
+			require.Error(t, err, in)
+			assert.Contains(t, err.Error(), "unsupported resource type")
+		}
+	})
+
+	t.Run("AliasesCollisions", func(t *testing.T) {
+		for a, rt := range resourceTypeAliases {
+			_, err := ResourceTypeString(string(rt))
+			require.NoError(t, err, a)
+
+			// An alias can not shadow a type or the short name of
+			// a type, as the types and short names are resolved first
+			_, err = ResourceTypeString(a)
+			assert.Error(t, err, a)
+			_, err = ResourceTypeString(resourceTypePrefix + a)
+			assert.Error(t, err, a)
+		}
+	})
+}
@@ -2,23 +2,3021 @@ package huaweicloud
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
 	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
 )
 
 type resourceReader func(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error)
 
 var resources = map[ResourceType]resourceReader{
-	ComputeInstance: emptyResourceReader,
-	VPC:             emptyResourceReader,
-	VPCSubnet:       emptyResourceReader,
-	EIP:             emptyResourceReader,
-	EVSVolume:       emptyResourceReader,
-	NatGateway:      emptyResourceReader,
-	OBSBucket:       emptyResourceReader,
+	ComputeInstance:       cacheInstances,
+	VPC:                   cacheVPCs,
+	VPCSubnet:             cacheVPCSubnets,
+	EIP:                   cacheEIPs,
+	EIPAssociate:          eipAssociates,
+	VPCRouteTable:         routeTables,
+	SecurityGroup:         cacheSecurityGroups,
+	EVSVolume:             cacheEVSVolumes,
+	NatGateway:            emptyResourceReader,
+	OBSBucket:             obsBuckets,
+	SFSFileSystem:         cacheSFSFileSystems,
+	SFSAccessRule:         sfsAccessRules,
+	AntiDDoSBasic:         antiDDoSBasics,
+	AADForwardRule:        aadForwardRules,
+	RDSInstance:           cacheRDSInstances,
+	GaussDBOpenGauss:      gaussDBOpenGaussInstances,
+	DRSJob:                drsJobs,
+	ASGroup:               cacheASGroups,
+	ASPolicy:              asPolicies,
+	ASLifecycleHook:       asLifecycleHooks,
+	SMNTopic:              cacheSMNTopics,
+	CESAlarmRule:          cesAlarmRules,
+	WorkspaceDesktop:      workspaceDesktops,
+	IdentityRole:          identityRoles,
+	DBSSInstance:          dbssInstances,
+	ELBCertificate:        cacheELBCertificates,
+	GESGraph:              gesGraphs,
+	DeHHost:               cacheDeHHosts,
+	OBSBucketACL:          obsBucketACLs,
+	DMSRocketMQ:           dmsRocketMQInstances,
+	DWSCluster:            dwsClusters,
+	ModelArtsNotebook:     modelArtsNotebooks,
+	RDSReadReplica:        rdsReadReplicas,
+	CFWInstance:           cacheCFWFirewalls,
+	CFWRule:               cfwProtectionRules,
+	EGEventChannel:        cacheEGEventChannels,
+	EGEventSubscription:   egEventSubscriptions,
+	DMSKafka:              cacheDMSKafkaInstances,
+	DMSKafkaTopic:         dmsKafkaTopics,
+	AOMAlarmRule:          aomAlarmRules,
+	DLIDatabase:           cacheDLIDatabases,
+	DLITable:              dliTables,
+	IoTDAProduct:          cacheIoTDAProducts,
+	IoTDADevice:           iotdaDevices,
+	ELBIpGroup:            cacheELBIPGroups,
+	CPHServer:             cphServers,
+	ELBMonitor:            elbMonitors,
+	VPCEPEndpoint:         vpcepEndpoints,
+	IMSImageShare:         imsImageShares,
+	SecMasterWorkspace:    secmasterWorkspaces,
+	ELBSecurityPolicy:     cacheELBSecurityPolicies,
+	ComputeVolumeAttach:   computeVolumeAttaches,
+	DMSKafkaConsumerGroup: dmsKafkaConsumerGroups,
+	RDSDatabase:           rdsDatabases,
+	RDSAccount:            rdsAccounts,
+	ELBL7Policy:           cacheELBL7Policies,
+	ELBL7Rule:             elbL7Rules,
+}
+
+// cachedResourceTypes are the types whose reader caches the resources
+// (ex: cacheVPCs), so they are shared with the types referencing them
+// (ex: the VPC of the subnets). It has to be updated with the readers
+// of the resources
+var cachedResourceTypes = map[ResourceType]struct{}{
+	ComputeInstance:   {},
+	VPC:               {},
+	VPCSubnet:         {},
+	EIP:               {},
+	SecurityGroup:     {},
+	EVSVolume:         {},
+	OBSBucket:         {},
+	SFSFileSystem:     {},
+	RDSInstance:       {},
+	ASGroup:           {},
+	SMNTopic:          {},
+	ELBCertificate:    {},
+	DeHHost:           {},
+	CFWInstance:       {},
+	EGEventChannel:    {},
+	DMSKafka:          {},
+	DLIDatabase:       {},
+	IoTDAProduct:      {},
+	ELBIpGroup:        {},
+	ELBSecurityPolicy: {},
+	ELBL7Policy:       {},
+}
+
+// truncateMaxPerType truncates the rs to the f.MaxPerType,
+// the readers stop listing once it's reached but the
+// last page read may have exceeded it
+func truncateMaxPerType(f *filter.Filter, rs []provider.Resource) []provider.Resource {
+	if f.IsMaxPerTypeReached(len(rs)) {
+		return rs[:f.MaxPerType]
+	}
+	return rs
 }
 
 func emptyResourceReader(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
 	return []provider.Resource{}, nil
 }
+
+// isStubbed checks if the reader of the resource type rt is the
+// emptyResourceReader, so it's not implemented yet and can only
+// be read from other sources (ex: the RMS)
+func isStubbed(rt ResourceType) bool {
+	rfn, ok := resources[rt]
+	if !ok {
+		return false
+	}
+	return reflect.ValueOf(rfn).Pointer() == reflect.ValueOf(emptyResourceReader).Pointer()
+}
+
+// isCached checks if the reader of the resource type rt caches the
+// resources, so they are shared with the types referencing them
+func isCached(rt ResourceType) bool {
+	_, ok := cachedResourceTypes[rt]
+	return ok
+}
+
+func instances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	var available map[string]reader.Flavor
+	if p.options.FlavorValidation != "" {
+		var err error
+		available, err = availableFlavors(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The disks are listed with the first instance
+	var disks map[string]serverDisks
+
+	// The EIPs are listed with the first instance
+	// if the Options.InlineEIPs is enabled
+	var eipIDs map[string]string
+
+	// The subnets with DHCP are listed with the first
+	// instance unless the Options.PinDHCPIPs is enabled
+	var dhcpListed bool
+
+	resources := make([]provider.Resource, 0)
+
+	epsIDs, err := enterpriseProjectIDs(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epsID := range epsIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		page := reader.Page{EnterpriseProjectID: epsID}
+		for {
+			servers, next, err := p.reader.ListServers(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list ECS instances")
+			}
+
+			for _, s := range servers {
+				c := serverCharging{Mode: serverChargingMode(s)}
+				if !chargingModeMatches(p, c.Mode) {
+					logSkipped(p, resourceType, s.ID, fmt.Sprintf("charging mode %q", c.Mode))
+					continue
+				}
+
+				setListedCreationTime(p, resourceType, s.ID, s.Created)
+				setListedStatus(p, resourceType, s.ID, s.Status)
+
+				if available != nil {
+					validateServerFlavor(p, s, available)
+				}
+
+				r := provider.NewResource(s.ID, resourceType, p)
+				if err := setEnterpriseProjectID(r, epsID); err != nil {
+					return nil, err
+				}
+
+				if a, ok := serverPowerAction(s.Status); ok {
+					if err := r.Data().Set("power_action", a); err != nil {
+						return nil, errors.Wrapf(err, "unable to set power_action data on the provider.Resource for the ECS instance %q", s.ID)
+					}
+				}
+
+				if c.Mode == ChargingModeSpot {
+					mi, err := p.reader.GetServerMarketInfo(ctx, s.ID)
+					if err != nil {
+						return nil, errors.Wrapf(err, "unable to get the market info of the ECS instance %q", s.ID)
+					}
+					c.Spot = mi.SpotOptions
+				}
+
+				if err := setServerCharging(r, c); err != nil {
+					return nil, err
+				}
+
+				if disks == nil {
+					disks, err = listServerDisks(ctx, p)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				if err := setServerDisks(p, r, disks[s.ID]); err != nil {
+					return nil, err
+				}
+
+				if !p.options.PinDHCPIPs && !dhcpListed {
+					if err := registerDHCPSubnets(ctx, p, f); err != nil {
+						return nil, err
+					}
+					dhcpListed = true
+				}
+
+				if p.options.InlineEIPs {
+					if eipIDs == nil {
+						eipIDs, err = listInlineServerEIPs(ctx, p, f)
+						if err != nil {
+							return nil, err
+						}
+					}
+
+					if id, ok := eipIDs[s.ID]; ok {
+						if err := r.Data().Set("eip_id", id); err != nil {
+							return nil, errors.Wrapf(err, "unable to set eip_id data on the provider.Resource for the ECS instance %q", s.ID)
+						}
+					}
+				}
+
+				p.instanceChargingMu.Lock()
+				p.instanceCharging[s.ID] = c
+				p.instanceChargingMu.Unlock()
+
+				if h := newServerSchedulerHints(s); h != (serverSchedulerHints{}) {
+					if err := setServerSchedulerHints(r, h); err != nil {
+						return nil, err
+					}
+
+					p.instanceSchedulerHintsMu.Lock()
+					p.instanceSchedulerHints[s.ID] = h
+					p.instanceSchedulerHintsMu.Unlock()
+				}
+
+				if tags := serverTags(s); len(tags) != 0 {
+					if err := r.Data().Set("tags", tags); err != nil {
+						return nil, errors.Wrapf(err, "unable to set tags data on the provider.Resource for the ECS instance %q", s.ID)
+					}
+
+					p.instanceTagsMu.Lock()
+					p.instanceTags[s.ID] = tags
+					p.instanceTagsMu.Unlock()
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// evsVolumes returns the EVS volumes, the system disks are skipped as
+// they are managed by the huaweicloud_compute_instance, as the data
+// disks are if the Options.InlineDataDisks is enabled. The attachments
+// are set so the computeVolumeAttaches can read them from the cache
+func evsVolumes(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		volumes, next, err := p.reader.ListEVSVolumes(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list EVS volumes")
+		}
+
+		for _, v := range volumes {
+			if v.SystemDisk() || inlineDataDisk(p, v) {
+				logSkipped(p, resourceType, v.ID, "inlined on its ECS instance")
+				continue
+			}
+			if mode := orderChargingMode(v.Metadata["orderID"]); !chargingModeMatches(p, mode) {
+				logSkipped(p, resourceType, v.ID, fmt.Sprintf("charging mode %q", mode))
+				continue
+			}
+
+			setListedCreationTime(p, resourceType, v.ID, v.CreatedAt)
+			setListedStatus(p, resourceType, v.ID, v.Status)
+
+			r := provider.NewResource(v.ID, resourceType, p)
+			if err := r.Data().Set("multiattach", v.Multiattach); err != nil {
+				return nil, errors.Wrapf(err, "unable to set multiattach data on the provider.Resource for the EVS volume %q", v.ID)
+			}
+
+			attachments := make([]interface{}, 0, len(v.Attachments))
+			for _, a := range v.Attachments {
+				attachments = append(attachments, map[string]interface{}{
+					"instance_id": a.ServerID,
+					"device":      a.Device,
+				})
+			}
+			if err := r.Data().Set("attachment", attachments); err != nil {
+				return nil, errors.Wrapf(err, "unable to set attachment data on the provider.Resource for the EVS volume %q", v.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// computeVolumeAttaches returns the attachments of the cached multi-attach
+// EVS volumes, one per ECS instance they are attached to, with the ID
+// 'INSTANCE_ID/VOLUME_ID'. The attachments to the instances that are not
+// on the cache are skipped. The other volumes have a single attachment
+// which is not imported, as their instance already has them
+func computeVolumeAttaches(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	volumes, err := cacheEVSVolumes(ctx, p, string(EVSVolume), f)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceIDs, err := getInstanceIDs(ctx, p, string(ComputeInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, v := range volumes {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		if multi, _ := v.Data().Get("multiattach").(bool); !multi {
+			continue
+		}
+
+		attachments, _ := v.Data().Get("attachment").(*schema.Set)
+		if attachments == nil {
+			continue
+		}
+
+		for _, a := range attachments.List() {
+			am := a.(map[string]interface{})
+			iid := am["instance_id"].(string)
+			id := fmt.Sprintf("%s/%s", iid, v.ID())
+
+			if _, ok := instanceIDs[iid]; !ok {
+				logSkipped(p, resourceType, id, fmt.Sprintf("the ECS instance %q is not imported", iid))
+				continue
+			}
+
+			r := provider.NewResource(id, resourceType, p)
+			if err := r.Data().Set("instance_id", iid); err != nil {
+				return nil, errors.Wrapf(err, "unable to set instance_id data on the provider.Resource for the volume attachment %q", id)
+			}
+			if err := r.Data().Set("volume_id", v.ID()); err != nil {
+				return nil, errors.Wrapf(err, "unable to set volume_id data on the provider.Resource for the volume attachment %q", id)
+			}
+			if dev, _ := am["device"].(string); dev != "" {
+				if err := r.Data().Set("device", dev); err != nil {
+					return nil, errors.Wrapf(err, "unable to set device data on the provider.Resource for the volume attachment %q", id)
+				}
+			}
+
+			resources = append(resources, r)
+
+			if f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].ID() < resources[j].ID()
+	})
+
+	return resources, nil
+}
+
+// vpcs returns the VPCs of each enterprise project, with their
+// secondary CIDRs inline as there is no resource to attach them
+func vpcs(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	epsIDs, err := enterpriseProjectIDs(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epsID := range epsIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		page := reader.Page{EnterpriseProjectID: epsID}
+		for {
+			vs, next, err := p.reader.ListVPCs(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list VPCs")
+			}
+
+			for _, v := range vs {
+				setListedCreationTime(p, resourceType, v.ID, v.CreatedAt)
+				setListedStatus(p, resourceType, v.ID, v.Status)
+
+				r := provider.NewResource(v.ID, resourceType, p)
+				if err := setEnterpriseProjectID(r, epsID); err != nil {
+					return nil, err
+				}
+
+				// The VPCs without secondary CIDRs do not have
+				// them set so the attribute is not written
+				if len(v.SecondaryCIDRs) != 0 {
+					cidrs := make([]interface{}, 0, len(v.SecondaryCIDRs))
+					for _, c := range v.SecondaryCIDRs {
+						cidrs = append(cidrs, c)
+					}
+					if err := r.Data().Set("secondary_cidrs", cidrs); err != nil {
+						return nil, errors.Wrapf(err, "unable to set secondary_cidrs data on the provider.Resource for the VPC %q", v.ID)
+					}
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+func vpcSubnets(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		subnets, next, err := p.reader.ListSubnets(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list VPC subnets")
+		}
+
+		for _, s := range subnets {
+			// The 'cidr' is required by the schema, so the
+			// IPv6-only subnets can not be represented
+			if s.CIDR == "" && s.IPv6CIDR != "" {
+				readerLogger(p, resourceType).Log("func", "huaweicloud.vpcSubnets", "id", s.ID, "msg", "skipping IPv6-only subnet, it has no IPv4 CIDR")
+				continue
+			}
+
+			setListedCreationTime(p, resourceType, s.ID, s.CreatedAt)
+			setListedStatus(p, resourceType, s.ID, s.Status)
+
+			r := provider.NewResource(s.ID, resourceType, p)
+
+			data := map[string]interface{}{
+				"vpc_id":      s.VpcID,
+				"ipv6_enable": s.IPv6Enable,
+				"dhcp_enable": s.DHCPEnable,
+			}
+			if s.CIDR != "" {
+				data["cidr"] = s.CIDR
+				data["gateway_ip"] = s.GatewayIP
+			}
+			if s.IPv6Enable {
+				data["ipv6_cidr"] = s.IPv6CIDR
+				data["ipv6_gateway"] = s.IPv6Gateway
+				data["ipv6_subnet_id"] = s.IPv6SubnetID
+			}
+			for k, v := range data {
+				if err := r.Data().Set(k, v); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the VPC subnet %q", k, s.ID)
+				}
+			}
+			if err := setSubnetDHCPOptions(r, s); err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+func eips(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	epsIDs, err := enterpriseProjectIDs(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epsID := range epsIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		page := reader.Page{EnterpriseProjectID: epsID}
+		for {
+			ips, next, err := p.reader.ListEIPs(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list EIPs")
+			}
+
+			for _, ip := range ips {
+				if mode := orderChargingMode(ip.Profile.OrderID); !chargingModeMatches(p, mode) {
+					logSkipped(p, resourceType, ip.ID, fmt.Sprintf("charging mode %q", mode))
+					continue
+				}
+
+				setListedCreationTime(p, resourceType, ip.ID, ip.CreateTime)
+				setListedStatus(p, resourceType, ip.ID, ip.Status)
+
+				r := provider.NewResource(ip.ID, resourceType, p)
+				if err := setEnterpriseProjectID(r, epsID); err != nil {
+					return nil, err
+				}
+				if err := r.Data().Set("address", ip.PublicIPAddress); err != nil {
+					return nil, errors.Wrapf(err, "unable to set address data on the provider.Resource for the EIP %q", ip.ID)
+				}
+				if ip.Alias != "" {
+					if err := r.Data().Set("name", ip.Alias); err != nil {
+						return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for the EIP %q", ip.ID)
+					}
+				}
+				if ip.PortID != "" {
+					if err := r.Data().Set("port_id", ip.PortID); err != nil {
+						return nil, errors.Wrapf(err, "unable to set port_id data on the provider.Resource for the EIP %q", ip.ID)
+					}
+				}
+				if err := setEIPBandwidth(r, ip); err != nil {
+					return nil, err
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// eipAssociates returns the associations of the cached EIPs with the
+// ports, the unassociated EIPs are skipped and so are the ones of the
+// ports of ECS instances that are not on the cache or, if the
+// Options.InlineEIPs is enabled, that have the EIP inlined
+func eipAssociates(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	eipRs, err := cacheEIPs(ctx, p, string(EIP), f)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceIDs, err := getInstanceIDs(ctx, p, string(ComputeInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := listPorts(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The EIPs inlined on the 'eip_id' of the ECS
+	// instances would conflict with the association
+	var inline map[string]string
+	if p.options.InlineEIPs {
+		inline = inlineServerEIPs(eipRs, ports)
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, eip := range eipRs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		portID, _ := eip.Data().Get("port_id").(string)
+		port, ok := ports[portID]
+		if !ok {
+			continue
+		}
+
+		// The ports of the ECS instances that are not
+		// imported would reference a missing instance
+		if _, ok := instanceIDs[port.DeviceID]; !ok && strings.HasPrefix(port.DeviceOwner, portDeviceOwnerCompute) {
+			logSkipped(p, resourceType, eip.ID(), fmt.Sprintf("the ECS instance %q is not imported", port.DeviceID))
+			continue
+		}
+
+		if id, ok := inline[port.DeviceID]; ok && id == eip.ID() {
+			logSkipped(p, resourceType, eip.ID(), "inlined on its ECS instance")
+			continue
+		}
+
+		r := provider.NewResource(eip.ID(), resourceType, p)
+		if err := r.Data().Set("public_ip", eip.Data().Get("address")); err != nil {
+			return nil, errors.Wrapf(err, "unable to set public_ip data on the provider.Resource for the EIP association %q", eip.ID())
+		}
+		if err := r.Data().Set("port_id", port.ID); err != nil {
+			return nil, errors.Wrapf(err, "unable to set port_id data on the provider.Resource for the EIP association %q", eip.ID())
+		}
+		if err := r.Data().Set("network_id", port.NetworkID); err != nil {
+			return nil, errors.Wrapf(err, "unable to set network_id data on the provider.Resource for the EIP association %q", eip.ID())
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// antiDDoSBasics returns the EIPs protected by the Anti-DDoS
+// basic service, which is scoped to the configured region
+func antiDDoSBasics(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	eipIDs, err := getEIPIDsByAddress(ctx, p, string(EIP), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		statuses, next, err := p.reader.ListAntiDDoSStatuses(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list Anti-DDoS statuses")
+		}
+
+		for _, s := range statuses {
+			// The EIP from the cache is preferred so the
+			// reference is the same as the imported EIP
+			eipID := s.FloatingIPID
+			if id, ok := eipIDs[s.FloatingIPAddress]; ok {
+				eipID = id
+			}
+
+			r := provider.NewResource(eipID, resourceType, p)
+			if err := r.Data().Set("eip_id", eipID); err != nil {
+				return nil, errors.Wrapf(err, "unable to set eip_id data on the provider.Resource for the Anti-DDoS %q", eipID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// aadForwardRules returns the forward rules of all the IPs protected
+// by the Advanced Anti-DDoS instances. AAD is a global service, so
+// the same rules are returned independently of the configured region
+func aadForwardRules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	instances, err := p.reader.ListAADInstances(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list AAD instances")
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range instances {
+		for _, ip := range i.IPs {
+			if f.IsMaxPerTypeReached(len(resources)) {
+				return resources, nil
+			}
+
+			rules, err := p.reader.ListAADForwardRules(ctx, i.ID, ip.IP)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list forward rules of AAD instance %q with IP %q", i.ID, ip.IP)
+			}
+
+			for _, rule := range rules {
+				r := provider.NewResource(fmt.Sprintf("%s/%s/%s/%d", i.ID, ip.IP, rule.ForwardProtocol, rule.ForwardPort), resourceType, p)
+				resources = append(resources, r)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+func rdsInstances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	// The default parameters are listed with the
+	// first instance that has parameters
+	defaults := &rdsDefaultParameters{p: p}
+
+	var page reader.Page
+	for {
+		instances, next, err := p.reader.ListRDSInstances(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list RDS instances")
+		}
+
+		for _, i := range instances {
+			if !chargingModeMatches(p, ChargingMode(i.ChargeInfo.ChargeMode)) {
+				logSkipped(p, resourceType, i.ID, fmt.Sprintf("charging mode %q", i.ChargeInfo.ChargeMode))
+				continue
+			}
+
+			// The replicas can not be imported as instances, they
+			// are imported by the rdsReadReplicas if enabled
+			if i.IsReplica() {
+				logSkipped(p, resourceType, i.ID, "read replica")
+				continue
+			}
+
+			setListedCreationTime(p, resourceType, i.ID, i.Created)
+			setListedStatus(p, resourceType, i.ID, i.Status)
+
+			if err := validateDBFlavor(ctx, p, resourceType, i.ID, i.Datastore.Type, i.FlavorRef); err != nil {
+				return nil, err
+			}
+
+			if err := registerRDSInstanceConfig(ctx, p, defaults, i); err != nil {
+				return nil, err
+			}
+
+			r := provider.NewResource(i.ID, resourceType, p)
+			if err := r.Data().Set("db", []interface{}{map[string]interface{}{
+				"type":    i.Datastore.Type,
+				"version": i.Datastore.Version,
+			}}); err != nil {
+				return nil, errors.Wrapf(err, "unable to set db data on the provider.Resource for the RDS instance %q", i.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// rdsReadReplicas returns the read replicas of the RDS instances if the
+// Options.RDSReadReplicas is enabled, if not they are imported as RDS
+// instances. The primary instance is set from the cache
+func rdsReadReplicas(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+	if !p.options.RDSReadReplicas {
+		return resources, nil
+	}
+
+	primaryIDs, err := getRDSInstanceIDs(ctx, p, string(RDSInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	var page reader.Page
+	for {
+		instances, next, err := p.reader.ListRDSInstances(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list RDS instances")
+		}
+
+		for _, i := range instances {
+			if !i.IsReplica() {
+				continue
+			}
+			if !chargingModeMatches(p, ChargingMode(i.ChargeInfo.ChargeMode)) {
+				logSkipped(p, resourceType, i.ID, fmt.Sprintf("charging mode %q", i.ChargeInfo.ChargeMode))
+				continue
+			}
+
+			if err := validateDBFlavor(ctx, p, resourceType, i.ID, i.Datastore.Type, i.FlavorRef); err != nil {
+				return nil, err
+			}
+
+			r := provider.NewResource(i.ID, resourceType, p)
+
+			if _, ok := primaryIDs[i.PrimaryID()]; ok {
+				if err := r.Data().Set("primary_instance_id", i.PrimaryID()); err != nil {
+					return nil, errors.Wrapf(err, "unable to set primary_instance_id data on the provider.Resource for the RDS read replica %q", i.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// gaussDBOpenGaussInstances returns the GaussDB(for openGauss) instances,
+// the distributed ones are a single resource independently of the number
+// of nodes. The VPC, subnet and security group are set from the cache
+func gaussDBOpenGaussInstances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	seen := make(map[string]struct{})
+
+	var page reader.Page
+	for {
+		instances, next, err := p.reader.ListGaussDBOpenGaussInstances(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list GaussDB(for openGauss) instances")
+		}
+
+		for _, i := range instances {
+			if _, ok := seen[i.ID]; ok {
+				continue
+			}
+			seen[i.ID] = struct{}{}
+
+			r := provider.NewResource(i.ID, resourceType, p)
+
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: i.VPCID, ids: vpcIDs},
+				{key: "subnet_id", id: i.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: i.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the GaussDB(for openGauss) instance %q", ref.key, i.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+var (
+	// drsDBUseTypes are all the types of DRS jobs
+	drsDBUseTypes = []string{"migration", "sync", "cloudDataGuard"}
+
+	// drsFinishedStatuses are the statuses of the DRS jobs
+	// that are already completed or deleted
+	drsFinishedStatuses = map[string]struct{}{
+		"FULL_TRANSFER_COMPLETE":          {},
+		"RELEASE_RESOURCE_COMPLETE":       {},
+		"RELEASE_CHILD_TRANSFER_COMPLETE": {},
+		"CHILD_TRANSFER_COMPLETE":         {},
+		"DELETED":                         {},
+	}
+)
+
+// drsJobs returns the DRS jobs of all the types, the completed and
+// deleted ones are skipped unless Options.DRSIncludeFinished. The
+// source and destination RDS instances are set from the cache
+func drsJobs(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	rdsIDs, err := getRDSInstanceIDs(ctx, p, string(RDSInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]reader.DRSJob, 0)
+	for _, t := range drsDBUseTypes {
+		if f.IsMaxPerTypeReached(len(jobs)) {
+			break
+		}
+
+		var page reader.Page
+		for {
+			js, next, err := p.reader.ListDRSJobs(ctx, t, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list DRS %s jobs", t)
+			}
+
+			for _, j := range js {
+				if _, ok := drsFinishedStatuses[j.Status]; ok && !p.options.DRSIncludeFinished {
+					logSkipped(p, resourceType, j.ID, fmt.Sprintf("finished status %v", j.Status))
+					continue
+				}
+				jobs = append(jobs, j)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(jobs)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	if len(jobs) == 0 {
+		return []provider.Resource{}, nil
+	}
+
+	ids := make([]string, 0, len(jobs))
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+	}
+
+	details, err := p.reader.GetDRSJobDetails(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get the DRS jobs details")
+	}
+
+	endpoints := make(map[string]reader.DRSJobDetail, len(details))
+	for _, d := range details {
+		endpoints[d.ID] = d
+	}
+
+	resources := make([]provider.Resource, 0, len(jobs))
+	for _, j := range jobs {
+		r := provider.NewResource(j.ID, resourceType, p)
+
+		d := endpoints[j.ID]
+		for k, e := range map[string]reader.DRSEndpoint{"source_db": d.SourceEndpoint, "destination_db": d.TargetEndpoint} {
+			if _, ok := rdsIDs[e.InstanceID]; !ok {
+				continue
+			}
+			if err := r.Data().Set(k, []interface{}{map[string]interface{}{"instance_id": e.InstanceID}}); err != nil {
+				return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DRS job %q", k, j.ID)
+			}
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func asGroups(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		groups, next, err := p.reader.ListASGroups(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list AS groups")
+		}
+
+		for _, g := range groups {
+			r := provider.NewResource(g.ID, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// asPolicies returns the scaling policies of each AS group, the
+// alarm triggered ones also have the 'alarm_id' set
+func asPolicies(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	groupIDs, err := getASGroupIDs(ctx, p, string(ASGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, gid := range groupIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		var page reader.Page
+		for {
+			policies, next, err := p.reader.ListASPolicies(ctx, gid, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list AS policies of the group %q", gid)
+			}
+
+			for _, pl := range policies {
+				r := provider.NewResource(pl.ID, resourceType, p)
+				if err := r.Data().Set("scaling_group_id", gid); err != nil {
+					return nil, errors.Wrapf(err, "unable to set scaling_group_id data on the provider.Resource for the AS policy %q", pl.ID)
+				}
+				if pl.Type == reader.ASPolicyTypeAlarm && pl.AlarmID != "" {
+					if err := r.Data().Set("alarm_id", pl.AlarmID); err != nil {
+						return nil, errors.Wrapf(err, "unable to set alarm_id data on the provider.Resource for the AS policy %q", pl.ID)
+					}
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// asLifecycleHooks returns the lifecycle hooks of each AS group, the
+// ID has the format 'GROUP_ID/HOOK_NAME' expected by the import. The
+// SMN topics of the notifications are set from the cache
+func asLifecycleHooks(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	groupIDs, err := getASGroupIDs(ctx, p, string(ASGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	topicURNs, err := getSMNTopicURNs(ctx, p, string(SMNTopic), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, gid := range groupIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		hooks, err := p.reader.ListASLifecycleHooks(ctx, gid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list AS lifecycle hooks of the group %q", gid)
+		}
+
+		for _, h := range hooks {
+			r := provider.NewResource(fmt.Sprintf("%s/%s", gid, h.Name), resourceType, p)
+			if err := r.Data().Set("scaling_group_id", gid); err != nil {
+				return nil, errors.Wrapf(err, "unable to set scaling_group_id data on the provider.Resource for the AS lifecycle hook %q", h.Name)
+			}
+			if _, ok := topicURNs[h.NotificationTopicURN]; ok {
+				if err := r.Data().Set("notification_topic_urn", h.NotificationTopicURN); err != nil {
+					return nil, errors.Wrapf(err, "unable to set notification_topic_urn data on the provider.Resource for the AS lifecycle hook %q", h.Name)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+// aomAlarmRules returns the AOM threshold alarm rules, the ones
+// created by default by the AOM are skipped. The SMN topics of
+// the actions are set from the cache
+func aomAlarmRules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	topicURNs, err := getSMNTopicURNs(ctx, p, string(SMNTopic), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		rules, next, err := p.reader.ListAOMAlarmRules(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list AOM alarm rules")
+		}
+
+		for _, ar := range rules {
+			id := ar.ID.String()
+			if ar.Type == reader.AOMAlarmRuleTypeDefault {
+				logSkipped(p, resourceType, id, "default alarm rule")
+				continue
+			}
+
+			r := provider.NewResource(id, resourceType, p)
+
+			actions := map[string][]string{
+				"alarm_actions":             ar.AlarmActions,
+				"ok_actions":                ar.OKActions,
+				"insufficient_data_actions": ar.InsufficientDataActions,
+			}
+			for k, as := range actions {
+				urns := make([]interface{}, 0, len(as))
+				for _, urn := range as {
+					if _, ok := topicURNs[urn]; ok {
+						urns = append(urns, urn)
+					}
+				}
+				if len(urns) == 0 {
+					continue
+				}
+				if err := r.Data().Set(k, urns); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the AOM alarm rule %q", k, id)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dliDatabases returns the DLI databases, the
+// default one of the project is skipped
+func dliDatabases(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		databases, next, err := p.reader.ListDLIDatabases(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list DLI databases")
+		}
+
+		for _, d := range databases {
+			if d.Name == reader.DLIDefaultDatabase {
+				logSkipped(p, resourceType, d.Name, "default database")
+				continue
+			}
+
+			// The databases are imported by name
+			r := provider.NewResource(d.Name, resourceType, p)
+			if err := r.Data().Set("name", d.Name); err != nil {
+				return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for the DLI database %q", d.Name)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dliTables returns the tables of each cached DLI database
+func dliTables(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	names, err := getDLIDatabaseNames(ctx, p, string(DLIDatabase), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, db := range names {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		var page reader.Page
+		for {
+			tables, next, err := p.reader.ListDLITables(ctx, db, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the DLI tables of the database %q", db)
+			}
+
+			for _, t := range tables {
+				// The tables are imported with the
+				// format '<database name>/<table name>'
+				id := fmt.Sprintf("%s/%s", db, t.Name)
+				r := provider.NewResource(id, resourceType, p)
+
+				data := map[string]interface{}{
+					"database_name": db,
+					"name":          t.Name,
+				}
+				for k, v := range data {
+					if err := r.Data().Set(k, v); err != nil {
+						return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DLI table %q", k, id)
+					}
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+func iotdaProducts(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		products, next, err := p.reader.ListIoTDAProducts(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list IoTDA products")
+		}
+
+		for _, pr := range products {
+			r := provider.NewResource(pr.ID, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// iotdaDevices returns the devices of each cached IoTDA product. The
+// products can have a huge number of devices, so the listing stops
+// once the max of the filter, or of the TypeFilter, is reached
+func iotdaDevices(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	productIDs, err := getIoTDAProductIDs(ctx, p, string(IoTDAProduct), f)
+	if err != nil {
+		return nil, err
+	}
+
+	max := maxPerType(p, f, resourceType)
+
+	resources := make([]provider.Resource, 0)
+products:
+	for _, pid := range productIDs {
+		var page reader.Page
+		for {
+			if max > 0 {
+				if len(resources) >= max {
+					break products
+				}
+				// Only the devices left to reach the max are listed
+				if left := max - len(resources); left < reader.DefaultLimit {
+					page.Limit = left
+				}
+			}
+
+			devices, next, err := p.reader.ListIoTDADevices(ctx, pid, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the IoTDA devices of the product %q", pid)
+			}
+
+			for _, d := range devices {
+				r := provider.NewResource(d.ID, resourceType, p)
+				if err := r.Data().Set("product_id", pid); err != nil {
+					return nil, errors.Wrapf(err, "unable to set product_id data on the provider.Resource for the IoTDA device %q", d.ID)
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+func smnTopics(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		topics, next, err := p.reader.ListSMNTopics(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list SMN topics")
+		}
+
+		for _, t := range topics {
+			r := provider.NewResource(t.URN, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// cesMonitoredResources are the dimensions of the CES namespaces
+// that identify the resources imported by other readers
+var cesMonitoredResources = map[string]struct {
+	dimensions map[string]struct{}
+	ids        func(ctx context.Context, p *huaweicloudProvider, f *filter.Filter) (map[string]struct{}, error)
+}{
+	"SYS.ECS": {
+		dimensions: map[string]struct{}{"instance_id": {}},
+		ids: func(ctx context.Context, p *huaweicloudProvider, f *filter.Filter) (map[string]struct{}, error) {
+			return getInstanceIDs(ctx, p, string(ComputeInstance), f)
+		},
+	},
+	"SYS.RDS": {
+		dimensions: map[string]struct{}{"rds_cluster_id": {}, "rds_instance_id": {}},
+		ids: func(ctx context.Context, p *huaweicloudProvider, f *filter.Filter) (map[string]struct{}, error) {
+			return getRDSInstanceIDs(ctx, p, string(RDSInstance), f)
+		},
+	},
+}
+
+// cesAlarmRules returns the Cloud Eye alarm rules, skipping the ones of
+// the system events. The monitored resources and the SMN topics of the
+// notifications are set from the cache, the monitored resources are only
+// read from the cache if an alarm rule of the namespace is found
+func cesAlarmRules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	topicURNs, err := getSMNTopicURNs(ctx, p, string(SMNTopic), f)
+	if err != nil {
+		return nil, err
+	}
+
+	monitoredIDs := make(map[string]map[string]struct{})
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		rules, next, err := p.reader.ListCESAlarmRules(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list CES alarm rules")
+		}
+
+		for _, ar := range rules {
+			if ar.Type == reader.CESAlarmTypeSystemEvent {
+				logSkipped(p, resourceType, ar.ID, "system event alarm rule")
+				continue
+			}
+
+			r := provider.NewResource(ar.ID, resourceType, p)
+
+			if mr, ok := cesMonitoredResources[ar.Namespace]; ok {
+				ids, ok := monitoredIDs[ar.Namespace]
+				if !ok {
+					ids, err = mr.ids(ctx, p, f)
+					if err != nil {
+						return nil, err
+					}
+					monitoredIDs[ar.Namespace] = ids
+				}
+
+				res := make([]interface{}, 0)
+				for _, rsc := range ar.Resources {
+					dims := make([]interface{}, 0)
+					for _, d := range rsc.Dimensions {
+						if _, ok := mr.dimensions[d.Name]; !ok {
+							continue
+						}
+						if _, ok := ids[d.Value]; !ok {
+							continue
+						}
+						dims = append(dims, map[string]interface{}{"name": d.Name, "value": d.Value})
+					}
+					if len(dims) != 0 {
+						res = append(res, map[string]interface{}{"dimensions": dims})
+					}
+				}
+				if len(res) != 0 {
+					if err := r.Data().Set("resources", res); err != nil {
+						return nil, errors.Wrapf(err, "unable to set resources data on the provider.Resource for the CES alarm rule %q", ar.ID)
+					}
+				}
+			}
+
+			actions := make([]interface{}, 0)
+			for _, n := range ar.AlarmNotifications {
+				urns := make([]interface{}, 0)
+				for _, urn := range n.NotificationList {
+					if _, ok := topicURNs[urn]; ok {
+						urns = append(urns, urn)
+					}
+				}
+				if len(urns) != 0 {
+					actions = append(actions, map[string]interface{}{"type": n.Type, "notification_list": urns})
+				}
+			}
+			if len(actions) != 0 {
+				if err := r.Data().Set("alarm_actions", actions); err != nil {
+					return nil, errors.Wrapf(err, "unable to set alarm_actions data on the provider.Resource for the CES alarm rule %q", ar.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// obsBuckets returns the OBS buckets of the configured region with
+// their access logging and storage quota, the target bucket of the
+// logging is logged if it's not one of the imported buckets as it can
+// not be referenced, and so are the buckets with the requester-pays
+// enabled as it can not be set on the huaweicloud_obs_bucket
+func obsBuckets(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	buckets, err := cacheOBSBuckets(ctx, p, resourceType, f)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{}, len(buckets))
+	for _, b := range buckets {
+		names[b.ID()] = struct{}{}
+	}
+
+	resources := make([]provider.Resource, 0, len(buckets))
+	for _, b := range buckets {
+		r := provider.NewResource(b.ID(), resourceType, p)
+
+		// The multi-AZ and the parallel file system can only be set on
+		// the creation, if they are wrong the bucket would be recreated
+		m, err := p.reader.GetOBSBucketMetadata(ctx, b.ID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get the metadata of the OBS bucket %q", b.ID())
+		}
+
+		if err := r.Data().Set("multi_az", m.MultiAZ); err != nil {
+			return nil, errors.Wrapf(err, "unable to set multi_az data on the provider.Resource for the OBS bucket %q", b.ID())
+		}
+		if err := r.Data().Set("parallel_fs", m.ParallelFS); err != nil {
+			return nil, errors.Wrapf(err, "unable to set parallel_fs data on the provider.Resource for the OBS bucket %q", b.ID())
+		}
+
+		st, err := p.reader.GetOBSBucketStorage(ctx, b.ID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get the storage of the OBS bucket %q", b.ID())
+		}
+
+		// The buckets without quota have it to 0, which
+		// is the default so it's omitted from the HCL
+		if st.Quota != 0 {
+			if err := r.Data().Set("quota", int(st.Quota)); err != nil {
+				return nil, errors.Wrapf(err, "unable to set quota data on the provider.Resource for the OBS bucket %q", b.ID())
+			}
+		}
+
+		// The requester-pays has no attribute on the
+		// huaweicloud_obs_bucket so it can only be logged
+		if st.RequesterPays {
+			readerLogger(p, resourceType).Log("func", "huaweicloud.obsBuckets", "bucket", b.ID(), "msg", "the requester-pays is enabled on the bucket, it can not be imported")
+		}
+
+		l, err := p.reader.GetOBSBucketLogging(ctx, b.ID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get the logging of the OBS bucket %q", b.ID())
+		}
+
+		// The buckets with the logging
+		// disabled have no target bucket
+		if l.TargetBucket != "" {
+			if _, ok := names[l.TargetBucket]; !ok {
+				readerLogger(p, resourceType).Log("func", "huaweicloud.obsBuckets", "bucket", b.ID(), "target", l.TargetBucket, "msg", "the logging target bucket is not imported, it will not be referenced")
+			}
+
+			err = r.Data().Set("logging", []interface{}{
+				map[string]interface{}{
+					"target_bucket": l.TargetBucket,
+					"target_prefix": l.TargetPrefix,
+					"agency":        l.Agency,
+				},
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to set the logging of the OBS bucket %q", b.ID())
+			}
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// obsBucketACLs returns the ACLs of the OBS buckets, one per bucket,
+// the buckets are registered so the inline 'acl' of them is removed
+// on the FixResource as both can not manage the ACL of the bucket
+func obsBucketACLs(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	buckets, err := cacheOBSBuckets(ctx, p, string(OBSBucket), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(buckets))
+	for _, b := range buckets {
+		r := provider.NewResource(b.ID(), resourceType, p)
+		if err := r.Data().Set("bucket", b.ID()); err != nil {
+			return nil, errors.Wrapf(err, "unable to set bucket data on the provider.Resource for the OBS bucket ACL %q", b.ID())
+		}
+
+		p.bucketACLsMu.Lock()
+		p.bucketACLs[b.ID()] = struct{}{}
+		p.bucketACLsMu.Unlock()
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// listOBSBuckets returns the OBS buckets of the configured region,
+// as the OBS API lists the buckets of all the regions
+func listOBSBuckets(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		buckets, next, err := p.reader.ListOBSBuckets(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list OBS buckets")
+		}
+
+		for _, b := range buckets {
+			if b.Location != "" && b.Location != p.Region() {
+				logSkipped(p, resourceType, b.Name, fmt.Sprintf("region %q", b.Location))
+				continue
+			}
+
+			r := provider.NewResource(b.Name, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// securityGroups returns the security groups, the 'default'
+// one of the project is skipped unless Options.IncludeDefaults
+func securityGroups(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	epsIDs, err := enterpriseProjectIDs(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epsID := range epsIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		page := reader.Page{EnterpriseProjectID: epsID}
+		for {
+			sgs, next, err := p.reader.ListSecurityGroups(ctx, page)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list security groups")
+			}
+
+			for _, sg := range sgs {
+				if skipDefault(p, resourceType, sg.ID, sg.Name == defaultSecurityGroupName) {
+					continue
+				}
+
+				r := provider.NewResource(sg.ID, resourceType, p)
+				if err := setEnterpriseProjectID(r, epsID); err != nil {
+					return nil, err
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// routeTables returns the VPC route tables, the default route
+// table of each VPC is skipped unless Options.IncludeDefaults
+func routeTables(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		rts, next, err := p.reader.ListRouteTables(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list VPC route tables")
+		}
+
+		for _, rt := range rts {
+			if skipDefault(p, resourceType, rt.ID, rt.Default) {
+				continue
+			}
+
+			r := provider.NewResource(rt.ID, resourceType, p)
+			if err := r.Data().Set("vpc_id", rt.VpcID); err != nil {
+				return nil, errors.Wrapf(err, "unable to set vpc_id data on the provider.Resource for the VPC route table %q", rt.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// sfsDeletingStatuses are the statuses of the
+// SFS shares that are being deleted
+var sfsDeletingStatuses = map[string]struct{}{
+	"deleting":       {},
+	"error_deleting": {},
+}
+
+// sfsFileSystems returns the classic SFS file systems, the ones being
+// deleted are skipped. The VPC of the access rules is set from the cache
+func sfsFileSystems(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		shares, next, err := p.reader.ListSFSShares(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list SFS file systems")
+		}
+
+		for _, s := range shares {
+			if _, ok := sfsDeletingStatuses[s.Status]; ok {
+				logSkipped(p, resourceType, s.ID, fmt.Sprintf("unsupported status %v", s.Status))
+				continue
+			}
+
+			setListedCreationTime(p, resourceType, s.ID, s.CreatedAt)
+			setListedStatus(p, resourceType, s.ID, s.Status)
+
+			rules, err := p.reader.ListSFSAccessRules(ctx, s.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the access rules of the SFS file system %q", s.ID)
+			}
+
+			r := provider.NewResource(s.ID, resourceType, p)
+			for _, ar := range rules {
+				if _, ok := vpcIDs[ar.AccessTo]; ok && ar.AccessType == reader.SFSAccessTypeVPC {
+					if err := r.Data().Set("access_to", ar.AccessTo); err != nil {
+						return nil, errors.Wrapf(err, "unable to set access_to data on the provider.Resource for the SFS file system %q", s.ID)
+					}
+					break
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// sfsAccessRules returns the access rules of the cached SFS file
+// systems, the ID has the format 'SFS_ID/RULE_ID' expected by the import
+func sfsAccessRules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	shareIDs, err := getSFSFileSystemIDs(ctx, p, string(SFSFileSystem), f)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, sid := range shareIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		rules, err := p.reader.ListSFSAccessRules(ctx, sid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list the access rules of the SFS file system %q", sid)
+		}
+
+		for _, ar := range rules {
+			r := provider.NewResource(fmt.Sprintf("%s/%s", sid, ar.ID), resourceType, p)
+			if err := r.Data().Set("sfs_id", sid); err != nil {
+				return nil, errors.Wrapf(err, "unable to set sfs_id data on the provider.Resource for the SFS access rule %q", ar.ID)
+			}
+			if _, ok := vpcIDs[ar.AccessTo]; ok && ar.AccessType == reader.SFSAccessTypeVPC {
+				if err := r.Data().Set("access_to", ar.AccessTo); err != nil {
+					return nil, errors.Wrapf(err, "unable to set access_to data on the provider.Resource for the SFS access rule %q", ar.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+// workspaceDesktops returns the Workspace cloud desktops, the ones being
+// deleted are skipped. The networks, the VPC of the networks and the
+// security groups are set from the cache
+func workspaceDesktops(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	subnetVPCs, err := getVPCSubnetVPCIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		desktops, next, err := p.reader.ListWorkspaceDesktops(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list Workspace desktops")
+		}
+
+		for _, d := range desktops {
+			if strings.EqualFold(d.Status, "deleting") || strings.EqualFold(d.TaskStatus, "deleting") {
+				logSkipped(p, resourceType, d.ID, "unsupported status deleting")
+				continue
+			}
+
+			r := provider.NewResource(d.ID, resourceType, p)
+			if err := r.Data().Set("user_name", d.UserName); err != nil {
+				return nil, errors.Wrapf(err, "unable to set user_name data on the provider.Resource for the Workspace desktop %q", d.ID)
+			}
+
+			networkIDs := make([]string, 0, len(d.Addresses))
+			for id := range d.Addresses {
+				networkIDs = append(networkIDs, id)
+			}
+			sort.Strings(networkIDs)
+
+			var vpcID string
+			nics := make([]map[string]interface{}, 0, len(networkIDs))
+			for _, id := range networkIDs {
+				vid, ok := subnetVPCs[id]
+				if !ok {
+					continue
+				}
+				vpcID = vid
+				nics = append(nics, map[string]interface{}{"network_id": id})
+			}
+			if len(nics) != 0 {
+				if err := r.Data().Set("nic", nics); err != nil {
+					return nil, errors.Wrapf(err, "unable to set nic data on the provider.Resource for the Workspace desktop %q", d.ID)
+				}
+				if err := r.Data().Set("vpc_id", vpcID); err != nil {
+					return nil, errors.Wrapf(err, "unable to set vpc_id data on the provider.Resource for the Workspace desktop %q", d.ID)
+				}
+			}
+
+			sgs := make([]string, 0, len(d.SecurityGroups))
+			for _, sg := range d.SecurityGroups {
+				if _, ok := sgIDs[sg.ID]; ok {
+					sgs = append(sgs, sg.ID)
+				}
+			}
+			if len(sgs) != 0 {
+				if err := r.Data().Set("security_groups", sgs); err != nil {
+					return nil, errors.Wrapf(err, "unable to set security_groups data on the provider.Resource for the Workspace desktop %q", d.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// identityRoles returns the IAM custom policies. IAM is a global
+// service, so the same policies are returned independently of
+// the configured region
+func identityRoles(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		roles, next, err := p.reader.ListIdentityRoles(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list IAM custom policies")
+		}
+
+		for _, ir := range roles {
+			r := provider.NewResource(ir.ID, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dbssProvisioningStatuses are the statuses of the DBSS
+// instances that are still being provisioned
+var dbssProvisioningStatuses = map[string]struct{}{
+	"BUILD": {},
+}
+
+// dbssInstances returns the DBSS audit instances, the ones that are
+// still being provisioned are skipped. The VPC, subnet and
+// security group are set from the cache
+func dbssInstances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.DBSSInstance]{
+		name: "DBSS instances",
+		// The DBSS instances are listed on a single page
+		list: func(ctx context.Context, _ reader.Page) ([]reader.DBSSInstance, string, error) {
+			instances, err := p.reader.ListDBSSInstances(ctx)
+			return instances, "", err
+		},
+		id: func(i reader.DBSSInstance) string { return i.ID },
+		skip: func(i reader.DBSSInstance) string {
+			if _, ok := dbssProvisioningStatuses[i.Status]; ok {
+				return fmt.Sprintf("unsupported status %v", i.Status)
+			}
+			return ""
+		},
+		set: func(r provider.Resource, i reader.DBSSInstance) error {
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: i.VPCID, ids: vpcIDs},
+				{key: "subnet_id", id: i.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: i.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DBSS instance %q", ref.key, i.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// elbCertificates returns the certificates of the dedicated load
+// balancers, the 'private_key' is removed by the FixResource
+// as the API never returns it
+func elbCertificates(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		certs, next, err := p.reader.ListELBCertificates(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list ELB certificates")
+		}
+
+		for _, c := range certs {
+			r := provider.NewResource(c.ID, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// elbIPGroups returns the ELB IP groups, the listeners using
+// them are set so they can be referenced from the cache
+func elbIPGroups(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		groups, next, err := p.reader.ListELBIPGroups(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list ELB IP groups")
+		}
+
+		for _, g := range groups {
+			r := provider.NewResource(g.ID, resourceType, p)
+
+			listeners := make([]interface{}, 0, len(g.Listeners))
+			for _, l := range g.Listeners {
+				listeners = append(listeners, l.ID)
+			}
+			if err := r.Data().Set("listener_ids", listeners); err != nil {
+				return nil, errors.Wrapf(err, "unable to set listener_ids data on the provider.Resource for the ELB IP group %q", g.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// elbSystemSecurityPolicies are the names of the TLS security
+// policies predefined by the ELB, which are not imported
+var elbSystemSecurityPolicies = map[string]struct{}{
+	"tls-1-0-inherit":       {},
+	"tls-1-0":               {},
+	"tls-1-1":               {},
+	"tls-1-2":               {},
+	"tls-1-2-strict":        {},
+	"tls-1-2-strict-no-cbc": {},
+	"tls-1-2-fs":            {},
+	"tls-1-0-with-1-3":      {},
+	"tls-1-2-fs-with-1-3":   {},
+	"hybrid-policy-1-0":     {},
+}
+
+// elbSecurityPolicies returns the custom ELB TLS security policies, the
+// system ones are skipped. The listeners using them are set so they
+// can be referenced from the cache
+func elbSecurityPolicies(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		policies, next, err := p.reader.ListELBSecurityPolicies(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list ELB security policies")
+		}
+
+		for _, sp := range policies {
+			if _, ok := elbSystemSecurityPolicies[sp.Name]; ok {
+				logSkipped(p, resourceType, sp.ID, "system security policy")
+				continue
+			}
+
+			r := provider.NewResource(sp.ID, resourceType, p)
+
+			listeners := make([]interface{}, 0, len(sp.Listeners))
+			for _, l := range sp.Listeners {
+				listeners = append(listeners, map[string]interface{}{"id": l.ID})
+			}
+			if err := r.Data().Set("listeners", listeners); err != nil {
+				return nil, errors.Wrapf(err, "unable to set listeners data on the provider.Resource for the ELB security policy %q", sp.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// elbMonitors returns the health checks of the ELB pools, one per pool
+// with a monitor as a pool has at most one, the pools are listed from the
+// cache and the 'pool_id' is set so it can be referenced from them
+func elbMonitors(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	pools, err := cacheELBPools(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, pl := range pools {
+		if pl.HealthMonitorID == "" {
+			continue
+		}
+
+		r := provider.NewResource(pl.HealthMonitorID, resourceType, p)
+		if err := r.Data().Set("pool_id", pl.ID); err != nil {
+			return nil, errors.Wrapf(err, "unable to set pool_id data on the provider.Resource for the ELB monitor %q", pl.HealthMonitorID)
+		}
+
+		resources = append(resources, r)
+
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+	}
+
+	return resources, nil
+}
+
+// elbL7Protocols are the protocols of the ELB
+// listeners that can have forwarding policies
+var elbL7Protocols = map[string]struct{}{
+	"HTTP":  {},
+	"HTTPS": {},
+}
+
+// elbL7Policies returns the forwarding policies of each ELB listener
+// with one of the elbL7Protocols, the listeners are listed from the
+// cache and the 'listener_id' is set so the rules can reference them
+func elbL7Policies(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	listeners, err := cacheELBListeners(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, l := range listeners {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		if _, ok := elbL7Protocols[l.Protocol]; !ok {
+			continue
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.ELBL7Policy]{
+			name: fmt.Sprintf("the forwarding policies of the ELB listener %q", l.ID),
+			list: func(ctx context.Context, page reader.Page) ([]reader.ELBL7Policy, string, error) {
+				return p.reader.ListELBL7Policies(ctx, l.ID, page)
+			},
+			id: func(pl reader.ELBL7Policy) string { return pl.ID },
+			set: func(r provider.Resource, pl reader.ELBL7Policy) error {
+				if err := r.Data().Set("listener_id", l.ID); err != nil {
+					return errors.Wrapf(err, "unable to set listener_id data on the provider.Resource for the ELB forwarding policy %q", pl.ID)
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// elbL7Rules returns the forwarding rules of each cached ELB
+// forwarding policy, with the 'l7policy_id' referencing it
+func elbL7Rules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	policyIDs, err := getELBL7PolicyIDs(ctx, p, string(ELBL7Policy), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, pid := range policyIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.ELBL7Rule]{
+			name: fmt.Sprintf("the forwarding rules of the ELB forwarding policy %q", pid),
+			list: func(ctx context.Context, page reader.Page) ([]reader.ELBL7Rule, string, error) {
+				return p.reader.ListELBL7Rules(ctx, pid, page)
+			},
+			// The rules are imported with the
+			// format '<policy id>/<rule id>'
+			id: func(rl reader.ELBL7Rule) string { return fmt.Sprintf("%s/%s", pid, rl.ID) },
+			set: func(r provider.Resource, rl reader.ELBL7Rule) error {
+				if err := r.Data().Set("l7policy_id", pid); err != nil {
+					return errors.Wrapf(err, "unable to set l7policy_id data on the provider.Resource for the ELB forwarding rule %q", rl.ID)
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// gesSkippedStatuses are the statuses of the GES graphs that
+// are still being created or that failed to be created
+var gesSkippedStatuses = map[string]struct{}{
+	"100": {},
+	"303": {},
+}
+
+// gesGraphs returns the GES graphs, the ones being created or that
+// failed to be created are skipped. The VPC, subnet and
+// security group are set from the cache
+func gesGraphs(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.GESGraph]{
+		name: "GES graphs",
+		list: p.reader.ListGESGraphs,
+		id:   func(g reader.GESGraph) string { return g.ID },
+		skip: func(g reader.GESGraph) string {
+			if _, ok := gesSkippedStatuses[g.Status]; ok {
+				return fmt.Sprintf("unsupported status %v", g.Status)
+			}
+			return ""
+		},
+		set: func(r provider.Resource, g reader.GESGraph) error {
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: g.VPCID, ids: vpcIDs},
+				{key: "subnet_id", id: g.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: g.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the GES graph %q", ref.key, g.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// cphSkippedStatuses are the statuses of the CPH servers that
+// are still being created or that failed to be created
+var cphSkippedStatuses = map[int]struct{}{
+	0: {},
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+}
+
+// cphServers returns the Cloud Phone servers, the ones being created
+// or that failed to be created are skipped. The VPC and subnet are
+// set from the cache
+func cphServers(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.CPHServer]{
+		name: "CPH servers",
+		list: p.reader.ListCPHServers,
+		id:   func(s reader.CPHServer) string { return s.ID },
+		skip: func(s reader.CPHServer) string {
+			if _, ok := cphSkippedStatuses[s.Status]; ok {
+				return fmt.Sprintf("unsupported status %v", s.Status)
+			}
+			return ""
+		},
+		set: func(r provider.Resource, s reader.CPHServer) error {
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: s.VPCID, ids: vpcIDs},
+				{key: "subnet_id", id: s.SubnetID, ids: subnetIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the CPH server %q", ref.key, s.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// vpcepSkippedStatuses are the statuses of the VPC endpoints
+// that are being created or deleted or that failed to be created
+var vpcepSkippedStatuses = map[string]struct{}{
+	"creating": {},
+	"deleting": {},
+	"failed":   {},
+}
+
+// vpcepEndpoints returns the VPC endpoints, the ones being created or
+// deleted or that failed to be created are skipped. The VPC is set from
+// the cache, and the endpoints with private DNS have the 'enable_dns'
+// and the 'private_domain_name', the first of their DNS names, the
+// other ones have none
+func vpcepEndpoints(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.VPCEPEndpoint]{
+		name: "VPC endpoints",
+		list: p.reader.ListVPCEPEndpoints,
+		id:   func(e reader.VPCEPEndpoint) string { return e.ID },
+		skip: func(e reader.VPCEPEndpoint) string {
+			if _, ok := vpcepSkippedStatuses[e.Status]; ok {
+				return fmt.Sprintf("unsupported status %q", e.Status)
+			}
+			return ""
+		},
+		created: func(e reader.VPCEPEndpoint) string { return e.CreatedAt },
+		status:  func(e reader.VPCEPEndpoint) string { return e.Status },
+		set: func(r provider.Resource, e reader.VPCEPEndpoint) error {
+			if _, ok := vpcIDs[e.VPCID]; ok {
+				if err := r.Data().Set("vpc_id", e.VPCID); err != nil {
+					return errors.Wrapf(err, "unable to set vpc_id data on the provider.Resource for the VPC endpoint %q", e.ID)
+				}
+			}
+
+			if e.EnableDNS {
+				if err := r.Data().Set("enable_dns", true); err != nil {
+					return errors.Wrapf(err, "unable to set enable_dns data on the provider.Resource for the VPC endpoint %q", e.ID)
+				}
+				if len(e.DNSNames) != 0 {
+					if err := r.Data().Set("private_domain_name", e.DNSNames[0]); err != nil {
+						return errors.Wrapf(err, "unable to set private_domain_name data on the provider.Resource for the VPC endpoint %q", e.ID)
+					}
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// secmasterWorkspaces returns the SecMaster workspaces of the configured
+// region, the API lists the ones of the project on all the regions so
+// the ones of other regions are skipped as they are imported with them
+func secmasterWorkspaces(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.SecMasterWorkspace]{
+		name: "SecMaster workspaces",
+		list: p.reader.ListSecMasterWorkspaces,
+		id:   func(w reader.SecMasterWorkspace) string { return w.ID },
+		skip: func(w reader.SecMasterWorkspace) string {
+			if w.RegionID != "" && w.RegionID != p.Region() {
+				return fmt.Sprintf("region %q", w.RegionID)
+			}
+			return ""
+		},
+	})
+}
+
+// dehHosts returns the Dedicated Hosts, they are cached
+// so the providers of the region can share them
+func dehHosts(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		hosts, next, err := p.reader.ListDeHHosts(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list Dedicated Hosts")
+		}
+
+		for _, h := range hosts {
+			r := provider.NewResource(h.ID, resourceType, p)
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dmsSkippedStatuses are the statuses of the DMS instances
+// that failed to be created or are being deleted
+var dmsSkippedStatuses = map[string]struct{}{
+	"CREATEFAILED": {},
+	"DELETING":     {},
+}
+
+// dmsRocketMQInstances returns the DMS RocketMQ instances with the
+// number of brokers and nodes, the VPC, subnet and security group
+// are set from the cache
+func dmsRocketMQInstances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		instances, next, err := p.reader.ListDMSRocketMQInstances(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list DMS RocketMQ instances")
+		}
+
+		for _, i := range instances {
+			if _, ok := dmsSkippedStatuses[i.Status]; ok {
+				logSkipped(p, resourceType, i.ID, fmt.Sprintf("unsupported status %v", i.Status))
+				continue
+			}
+
+			r := provider.NewResource(i.ID, resourceType, p)
+
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: i.VPCID, ids: vpcIDs},
+				{key: "subnet_id", id: i.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: i.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DMS RocketMQ instance %q", ref.key, i.ID)
+				}
+			}
+
+			counts := map[string]int{
+				"broker_num": i.BrokerNum,
+				"node_num":   i.NodeNum,
+			}
+			for k, n := range counts {
+				if n == 0 {
+					continue
+				}
+				if err := r.Data().Set(k, n); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DMS RocketMQ instance %q", k, i.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dmsKafkaInstances returns the DMS Kafka instances with the
+// number of brokers, the VPC, subnet and security group are
+// set from the cache
+func dmsKafkaInstances(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		instances, next, err := p.reader.ListDMSKafkaInstances(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list DMS Kafka instances")
+		}
+
+		for _, i := range instances {
+			if _, ok := dmsSkippedStatuses[i.Status]; ok {
+				logSkipped(p, resourceType, i.ID, fmt.Sprintf("unsupported status %v", i.Status))
+				continue
+			}
+
+			setListedCreationTime(p, resourceType, i.ID, i.CreatedAt)
+			setListedStatus(p, resourceType, i.ID, i.Status)
+
+			r := provider.NewResource(i.ID, resourceType, p)
+
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: i.VPCID, ids: vpcIDs},
+				{key: "network_id", id: i.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: i.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return nil, errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DMS Kafka instance %q", ref.key, i.ID)
+				}
+			}
+
+			if i.BrokerNum != 0 {
+				if err := r.Data().Set("broker_num", i.BrokerNum); err != nil {
+					return nil, errors.Wrapf(err, "unable to set broker_num data on the provider.Resource for the DMS Kafka instance %q", i.ID)
+				}
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// dmsKafkaSystemTopics are the internal topics of the Kafka
+// instances, they are created by Kafka and can not be managed
+var dmsKafkaSystemTopics = map[string]struct{}{
+	"__consumer_offsets":  {},
+	"__transaction_state": {},
+	"__trace":             {},
+}
+
+// dmsKafkaTopics returns the topics of each cached DMS
+// Kafka instance, the system topics are skipped
+func dmsKafkaTopics(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	instanceIDs, err := getDMSKafkaInstanceIDs(ctx, p, string(DMSKafka), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, iid := range instanceIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		var page reader.Page
+		for {
+			topics, next, err := p.reader.ListDMSKafkaTopics(ctx, iid, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the DMS Kafka topics of the instance %q", iid)
+			}
+
+			for _, t := range topics {
+				if _, ok := dmsKafkaSystemTopics[t.Name]; ok {
+					logSkipped(p, resourceType, fmt.Sprintf("%s/%s", iid, t.Name), "system topic")
+					continue
+				}
+
+				// The topics are imported with the
+				// format '<instance id>/<topic name>'
+				r := provider.NewResource(fmt.Sprintf("%s/%s", iid, t.Name), resourceType, p)
+				if err := r.Data().Set("instance_id", iid); err != nil {
+					return nil, errors.Wrapf(err, "unable to set instance_id data on the provider.Resource for the DMS Kafka topic %q", t.Name)
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// dmsKafkaInternalGroupPrefix is the prefix of the internal consumer
+// groups of the DMS Kafka instances, which are not managed by the users
+const dmsKafkaInternalGroupPrefix = "__"
+
+// dmsKafkaConsumerGroups returns the consumer groups of each cached
+// DMS Kafka instance, the internal consumer groups are skipped
+func dmsKafkaConsumerGroups(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	instanceIDs, err := getDMSKafkaInstanceIDs(ctx, p, string(DMSKafka), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, iid := range instanceIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.DMSKafkaConsumerGroup]{
+			name: fmt.Sprintf("the DMS Kafka consumer groups of the instance %q", iid),
+			list: func(ctx context.Context, page reader.Page) ([]reader.DMSKafkaConsumerGroup, string, error) {
+				return p.reader.ListDMSKafkaConsumerGroups(ctx, iid, page)
+			},
+			// The consumer groups are imported with
+			// the format '<instance id>/<group name>'
+			id: func(g reader.DMSKafkaConsumerGroup) string { return fmt.Sprintf("%s/%s", iid, g.Name) },
+			skip: func(g reader.DMSKafkaConsumerGroup) string {
+				if strings.HasPrefix(g.Name, dmsKafkaInternalGroupPrefix) {
+					return "internal consumer group"
+				}
+				return ""
+			},
+			set: func(r provider.Resource, g reader.DMSKafkaConsumerGroup) error {
+				if err := r.Data().Set("instance_id", iid); err != nil {
+					return errors.Wrapf(err, "unable to set instance_id data on the provider.Resource for the DMS Kafka consumer group %q", g.Name)
+				}
+				if err := r.Data().Set("name", g.Name); err != nil {
+					return errors.Wrapf(err, "unable to set name data on the provider.Resource for the DMS Kafka consumer group %q", g.Name)
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// rdsSystemDatabases are the databases created by the
+// RDS on the MySQL instances, which can not be managed
+var rdsSystemDatabases = map[string]struct{}{
+	"mysql":              {},
+	"sys":                {},
+	"information_schema": {},
+	"performance_schema": {},
+	"__recycle_bin__":    {},
+}
+
+// rdsDatabases returns the databases of each cached MySQL RDS
+// instance, the rdsSystemDatabases are skipped
+func rdsDatabases(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	instanceIDs, err := getRDSMySQLInstanceIDs(ctx, p, string(RDSInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, iid := range instanceIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.RDSDatabase]{
+			name: fmt.Sprintf("the databases of the RDS instance %q", iid),
+			list: func(ctx context.Context, page reader.Page) ([]reader.RDSDatabase, string, error) {
+				return p.reader.ListRDSDatabases(ctx, iid, page)
+			},
+			// The databases are imported with the
+			// format '<instance id>/<database name>'
+			id: func(db reader.RDSDatabase) string { return fmt.Sprintf("%s/%s", iid, db.Name) },
+			skip: func(db reader.RDSDatabase) string {
+				if _, ok := rdsSystemDatabases[db.Name]; ok {
+					return "system database"
+				}
+				return ""
+			},
+			set: func(r provider.Resource, db reader.RDSDatabase) error {
+				if err := r.Data().Set("instance_id", iid); err != nil {
+					return errors.Wrapf(err, "unable to set instance_id data on the provider.Resource for the RDS database %q", r.ID())
+				}
+				if err := r.Data().Set("name", db.Name); err != nil {
+					return errors.Wrapf(err, "unable to set name data on the provider.Resource for the RDS database %q", r.ID())
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// rdsSystemAccounts are the accounts created by the RDS on the MySQL
+// instances, the 'root' one is the administrator of the instance
+// which is managed with the 'db' of the huaweicloud_rds_instance
+var rdsSystemAccounts = map[string]struct{}{
+	"root":             {},
+	"rdsAdmin":         {},
+	"rdsBackup":        {},
+	"rdsMetric":        {},
+	"rdsProxy":         {},
+	"rdsRepl":          {},
+	"mysql.infoschema": {},
+	"mysql.session":    {},
+	"mysql.sys":        {},
+}
+
+// rdsAccounts returns the database accounts of each cached MySQL RDS
+// instance, the rdsSystemAccounts are skipped. Their 'password' is
+// never returned by the API so it's not set and has to be defined
+// on the HCL before applying it
+func rdsAccounts(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	instanceIDs, err := getRDSMySQLInstanceIDs(ctx, p, string(RDSInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, iid := range instanceIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.RDSAccount]{
+			name: fmt.Sprintf("the accounts of the RDS instance %q", iid),
+			list: func(ctx context.Context, page reader.Page) ([]reader.RDSAccount, string, error) {
+				return p.reader.ListRDSAccounts(ctx, iid, page)
+			},
+			// The accounts are imported with the
+			// format '<instance id>/<account name>'
+			id: func(a reader.RDSAccount) string { return fmt.Sprintf("%s/%s", iid, a.Name) },
+			skip: func(a reader.RDSAccount) string {
+				if _, ok := rdsSystemAccounts[a.Name]; ok {
+					return "system account"
+				}
+				return ""
+			},
+			set: func(r provider.Resource, a reader.RDSAccount) error {
+				if err := r.Data().Set("instance_id", iid); err != nil {
+					return errors.Wrapf(err, "unable to set instance_id data on the provider.Resource for the RDS account %q", r.ID())
+				}
+				if err := r.Data().Set("name", a.Name); err != nil {
+					return errors.Wrapf(err, "unable to set name data on the provider.Resource for the RDS account %q", r.ID())
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// dwsProvisioningStatuses are the statuses of the DWS clusters
+// that are still being created or that failed to be created
+var dwsProvisioningStatuses = map[string]struct{}{
+	"CREATING":        {},
+	"CREATE_FAILED":   {},
+	"CREATION FAILED": {},
+}
+
+// dwsClusters returns the DWS clusters with their node type and number
+// of nodes, the ones that are still being created are skipped. The VPC,
+// subnet and security group are set from the cache
+func dwsClusters(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	vpcIDs, err := getVPCIDs(ctx, p, string(VPC), f)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), f)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, err := getSecurityGroupIDs(ctx, p, string(SecurityGroup), f)
+	if err != nil {
+		return nil, err
+	}
+
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.DWSCluster]{
+		name: "DWS clusters",
+		// The DWS clusters are listed on a single page
+		list: func(ctx context.Context, _ reader.Page) ([]reader.DWSCluster, string, error) {
+			clusters, err := p.reader.ListDWSClusters(ctx)
+			return clusters, "", err
+		},
+		id: func(c reader.DWSCluster) string { return c.ID },
+		skip: func(c reader.DWSCluster) string {
+			if _, ok := dwsProvisioningStatuses[c.Status]; ok {
+				return fmt.Sprintf("unsupported status %v", c.Status)
+			}
+			return ""
+		},
+		created: func(c reader.DWSCluster) string { return c.Created },
+		status:  func(c reader.DWSCluster) string { return c.Status },
+		set: func(r provider.Resource, c reader.DWSCluster) error {
+			if err := r.Data().Set("node_type", c.NodeType); err != nil {
+				return errors.Wrapf(err, "unable to set node_type data on the provider.Resource for the DWS cluster %q", c.ID)
+			}
+			if err := r.Data().Set("number_of_node", c.NumberOfNode); err != nil {
+				return errors.Wrapf(err, "unable to set number_of_node data on the provider.Resource for the DWS cluster %q", c.ID)
+			}
+
+			// The subnet is the 'network_id' of the cluster
+			refs := []struct {
+				key string
+				id  string
+				ids map[string]struct{}
+			}{
+				{key: "vpc_id", id: c.VPCID, ids: vpcIDs},
+				{key: "network_id", id: c.SubnetID, ids: subnetIDs},
+				{key: "security_group_id", id: c.SecurityGroupID, ids: sgIDs},
+			}
+			for _, ref := range refs {
+				if _, ok := ref.ids[ref.id]; !ok {
+					continue
+				}
+				if err := r.Data().Set(ref.key, ref.id); err != nil {
+					return errors.Wrapf(err, "unable to set %s data on the provider.Resource for the DWS cluster %q", ref.key, c.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// modelArtsDeletingStatuses are the statuses of the
+// ModelArts notebooks that are being or were deleted
+var modelArtsDeletingStatuses = map[string]struct{}{
+	"DELETING": {},
+	"DELETED":  {},
+}
+
+// modelArtsNotebooks returns the ModelArts notebooks, the ones being
+// deleted are skipped. They have no network to reference as they run
+// on the ModelArts network or on a resource pool
+func modelArtsNotebooks(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.ModelArtsNotebook]{
+		name: "ModelArts notebooks",
+		list: p.reader.ListModelArtsNotebooks,
+		id:   func(n reader.ModelArtsNotebook) string { return n.ID },
+		skip: func(n reader.ModelArtsNotebook) string {
+			if _, ok := modelArtsDeletingStatuses[n.Status]; ok {
+				return fmt.Sprintf("unsupported status %v", n.Status)
+			}
+			return ""
+		},
+	})
+}
+
+// cfwSkippedStatuses are the statuses of the CFW firewalls that are
+// pending the payment, being created or deleted, or that failed to
+// be created
+var cfwSkippedStatuses = map[int]struct{}{
+	-1: {},
+	0:  {},
+	1:  {},
+	4:  {},
+	6:  {},
+}
+
+// cfwFirewalls returns the CFW firewalls, the ones that are not yet
+// or no longer running are skipped. They have the 'protect_objects'
+// set so their protection rules can be read from the cache
+func cfwFirewalls(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+
+	var page reader.Page
+	for {
+		firewalls, next, err := p.reader.ListCFWFirewalls(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list CFW firewalls")
+		}
+
+		for _, fw := range firewalls {
+			if _, ok := cfwSkippedStatuses[fw.Status]; ok {
+				logSkipped(p, resourceType, fw.ID, fmt.Sprintf("unsupported status %v", fw.Status))
+				continue
+			}
+
+			r := provider.NewResource(fw.ID, resourceType, p)
+
+			objects := make([]interface{}, 0, len(fw.ProtectObjects))
+			for _, o := range fw.ProtectObjects {
+				objects = append(objects, map[string]interface{}{
+					"object_id":   o.ObjectID,
+					"object_name": o.ObjectName,
+					"type":        o.Type,
+				})
+			}
+			if err := r.Data().Set("protect_objects", objects); err != nil {
+				return nil, errors.Wrapf(err, "unable to set protect_objects data on the provider.Resource for the CFW firewall %q", fw.ID)
+			}
+
+			resources = append(resources, r)
+		}
+
+		if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+		page.Marker = next
+	}
+
+	return resources, nil
+}
+
+// cfwProtectionRules returns the protection rules of the Internet border
+// of each cached CFW firewall, the ID has the format 'OBJECT_ID/RULE_ID'
+// expected by the import
+func cfwProtectionRules(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	objectIDs, err := getCFWFirewallObjectIDs(ctx, p, string(CFWInstance), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, oid := range objectIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		var page reader.Page
+		for {
+			rules, next, err := p.reader.ListCFWProtectionRules(ctx, oid, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the CFW protection rules of the object %q", oid)
+			}
+
+			for _, pr := range rules {
+				r := provider.NewResource(fmt.Sprintf("%s/%s", oid, pr.ID), resourceType, p)
+				if err := r.Data().Set("object_id", oid); err != nil {
+					return nil, errors.Wrapf(err, "unable to set object_id data on the provider.Resource for the CFW protection rule %q", pr.ID)
+				}
+
+				resources = append(resources, r)
+			}
+
+			if next == "" || f.IsMaxPerTypeReached(len(resources)) {
+				break
+			}
+			page.Marker = next
+		}
+	}
+
+	return resources, nil
+}
+
+// egEventChannels returns the EventGrid custom event channels, the
+// official ones are created by Huawei Cloud and can not be imported
+func egEventChannels(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	return readPaged(ctx, p, resourceType, f, pagedReader[reader.EGEventChannel]{
+		name: "EG event channels",
+		list: p.reader.ListEGEventChannels,
+		id:   func(c reader.EGEventChannel) string { return c.ID },
+	})
+}
+
+// egEventSubscriptions returns the event subscriptions
+// of each cached EventGrid custom event channel
+func egEventSubscriptions(ctx context.Context, p *huaweicloudProvider, resourceType string, f *filter.Filter) ([]provider.Resource, error) {
+	channelIDs, err := getEGEventChannelIDs(ctx, p, string(EGEventChannel), f)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, cid := range channelIDs {
+		if f.IsMaxPerTypeReached(len(resources)) {
+			break
+		}
+
+		resources, err = appendPaged(ctx, p, resourceType, f, resources, pagedReader[reader.EGEventSubscription]{
+			name: fmt.Sprintf("the EG event subscriptions of the channel %q", cid),
+			list: func(ctx context.Context, page reader.Page) ([]reader.EGEventSubscription, string, error) {
+				return p.reader.ListEGEventSubscriptions(ctx, cid, page)
+			},
+			id: func(es reader.EGEventSubscription) string { return es.ID },
+			set: func(r provider.Resource, es reader.EGEventSubscription) error {
+				if err := r.Data().Set("channel_id", cid); err != nil {
+					return errors.Wrapf(err, "unable to set channel_id data on the provider.Resource for the EG event subscription %q", es.ID)
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,2792 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/cache"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProvider returns a huaweicloudProvider
+// which reads from the mocked Reader r
+func newTestProvider(t *testing.T, r reader.Reader) *huaweicloudProvider {
+	p, err := NewProvider(context.Background(), "cn-north-1", "123456", "access", "secret", "", Options{})
+	require.NoError(t, err)
+
+	hp := p.(*huaweicloudProvider)
+	hp.reader = r
+
+	return hp
+}
+
+func TestAntiDDoSBasics(t *testing.T) {
+	t.Run("ReferencesCachedEIP", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListEIPs(ctx, reader.Page{}).Return([]reader.EIP{
+			{ID: "eip-1", PublicIPAddress: "1.1.1.1"},
+			{ID: "eip-2", PublicIPAddress: "2.2.2.2"},
+		}, "", nil).Times(1)
+		r.EXPECT().ListAntiDDoSStatuses(ctx, reader.Page{}).Return([]reader.AntiDDoSStatus{
+			{FloatingIPID: "stale-id", FloatingIPAddress: "2.2.2.2"},
+			{FloatingIPID: "eip-3", FloatingIPAddress: "3.3.3.3"},
+		}, "", nil)
+
+		// The EIPs are read first so they are on the cache
+		eips, err := p.Resources(ctx, string(EIP), &filter.Filter{})
+		require.NoError(t, err)
+		require.NoError(t, p.cache.Set(string(EIP), eips))
+
+		rs, err := p.Resources(ctx, string(AntiDDoSBasic), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "eip-2", rs[0].ID())
+		assert.Equal(t, "eip-2", rs[0].Data().Get("eip_id"))
+		assert.Equal(t, "eip-3", rs[1].ID())
+		assert.Equal(t, "eip-3", rs[1].Data().Get("eip_id"))
+	})
+	t.Run("ListsEIPsWhenNotCached", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListEIPs(ctx, reader.Page{}).Return([]reader.EIP{
+			{ID: "eip-1", PublicIPAddress: "1.1.1.1"},
+		}, "", nil)
+		r.EXPECT().ListAntiDDoSStatuses(ctx, reader.Page{}).Return([]reader.AntiDDoSStatus{
+			{FloatingIPID: "other-id", FloatingIPAddress: "1.1.1.1"},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(AntiDDoSBasic), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "eip-1", rs[0].Data().Get("eip_id"))
+
+		_, err = p.cache.Get(string(EIP))
+		assert.NoError(t, err)
+	})
+}
+
+func TestAADForwardRules(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListAADInstances(ctx).Return([]reader.AADInstance{
+		{ID: "aad-1", IPs: []reader.AADIP{{IP: "4.4.4.4"}}},
+	}, nil)
+	r.EXPECT().ListAADForwardRules(ctx, "aad-1", "4.4.4.4").Return([]reader.AADForwardRule{
+		{ForwardProtocol: "tcp", ForwardPort: 80},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(AADForwardRule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "aad-1/4.4.4.4/tcp/80", rs[0].ID())
+}
+
+func TestInstances(t *testing.T) {
+	flavors := []reader.Flavor{
+		{ID: "s6.large.2", Name: "s6.large.2", VCPUs: "2", RAM: 4096},
+		{ID: "s6.xlarge.2", Name: "s6.xlarge.2", VCPUs: "4", RAM: 8192},
+		{ID: "c7.large.2", Name: "c7.large.2", VCPUs: "2", RAM: 4096},
+		{ID: "s3.large.2", Name: "s3.large.2", VCPUs: "2", RAM: 4096, ExtraSpecs: map[string]string{reader.FlavorStatusKey: "abandon"}},
+	}
+	servers := []reader.Server{
+		{ID: "ecs-1", Flavor: reader.ServerFlavor{ID: "s6.xlarge.2", VCPUs: "4", RAM: "8192"}},
+		{ID: "ecs-2", Flavor: reader.ServerFlavor{ID: "s3.large.2", VCPUs: "2", RAM: "4096"}},
+		{ID: "ecs-3", Flavor: reader.ServerFlavor{ID: "s6.2xlarge.2", VCPUs: "8", RAM: "16384"}},
+	}
+
+	t.Run("WithoutValidation", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListServers(ctx, reader.Page{}).Return(servers, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+		rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+		assert.Empty(t, p.flavorSubstitutes)
+	})
+	t.Run("Warn", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+		p.options.FlavorValidation = FlavorValidationWarn
+
+		r.EXPECT().ListFlavors(ctx).Return(flavors, nil)
+		r.EXPECT().ListServers(ctx, reader.Page{}).Return(servers, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+		rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+		assert.Empty(t, p.flavorSubstitutes)
+	})
+	t.Run("Substitute", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+		p.options.FlavorValidation = FlavorValidationSubstitute
+
+		r.EXPECT().ListFlavors(ctx).Return(flavors, nil)
+		r.EXPECT().ListServers(ctx, reader.Page{}).Return(servers, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+		rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+
+		// ecs-1 has an available flavor, ecs-2 has a deprecated one
+		// without available flavors of the same family and ecs-3
+		// one that is not listed but has flavors of the same family
+		require.Len(t, p.flavorSubstitutes, 2)
+		assert.Equal(t, "c7.large.2", p.flavorSubstitutes["ecs-2"].ID)
+		assert.Equal(t, "s6.xlarge.2", p.flavorSubstitutes["ecs-3"].ID)
+
+		v, err := p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+			"id":          cty.StringVal("ecs-2"),
+			"flavor_id":   cty.StringVal("s3.large.2"),
+			"flavor_name": cty.StringVal("s3.large.2"),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "c7.large.2", v.GetAttr("flavor_id").AsString())
+		assert.Equal(t, "c7.large.2", v.GetAttr("flavor_name").AsString())
+
+		v, err = p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+			"id":          cty.StringVal("ecs-1"),
+			"flavor_id":   cty.StringVal("s6.xlarge.2"),
+			"flavor_name": cty.StringVal("s6.xlarge.2"),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "s6.xlarge.2", v.GetAttr("flavor_id").AsString())
+	})
+	t.Run("CachedFlavors", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			ctx  = context.Background()
+			c    = cache.New()
+		)
+		defer ctrl.Finish()
+
+		newProvider := func(t *testing.T, region string) *huaweicloudProvider {
+			p, err := NewProvider(ctx, region, "123456", "access", "secret", "", Options{FlavorValidation: FlavorValidationWarn}, WithCache(c))
+			require.NoError(t, err)
+
+			hp := p.(*huaweicloudProvider)
+			hp.reader = r
+			return hp
+		}
+
+		// The flavors are listed once per region, the
+		// next readers of the region use the cached ones
+		r.EXPECT().ListFlavors(ctx).Return(flavors, nil).Times(2)
+		r.EXPECT().ListServers(ctx, reader.Page{}).Return(servers, "", nil).Times(2)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil).Times(2)
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil).Times(2)
+
+		for _, region := range []string{"cn-north-1", "cn-south-1"} {
+			hp := newProvider(t, region)
+			rs, err := hp.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+			require.NoError(t, err)
+			require.Len(t, rs, 3)
+
+			cached, err := c.Get(hp.cache.(*syncCache).cache.(*scopedCache).scope + flavorsCacheKey)
+			require.NoError(t, err)
+			require.Len(t, cached, len(flavors))
+
+			available, err := availableFlavors(ctx, hp)
+			require.NoError(t, err)
+			assert.Len(t, available, 3)
+			assert.NotContains(t, available, "s3.large.2")
+		}
+	})
+}
+
+func TestDRSJobs(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{
+		{ID: "rds-source"},
+		{ID: "rds-target"},
+	}, "", nil)
+	r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+	r.EXPECT().ListDRSJobs(ctx, "migration", reader.Page{}).Return([]reader.DRSJob{
+		{ID: "job-active", Status: "INCRE_TRANSFER_STARTED"},
+		{ID: "job-completed", Status: "RELEASE_RESOURCE_COMPLETE"},
+	}, "", nil)
+	r.EXPECT().ListDRSJobs(ctx, "sync", reader.Page{}).Return([]reader.DRSJob{
+		{ID: "job-external", Status: "INCRE_TRANSFER_STARTED"},
+	}, "", nil)
+	r.EXPECT().ListDRSJobs(ctx, "cloudDataGuard", reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().GetDRSJobDetails(ctx, []string{"job-active", "job-external"}).Return([]reader.DRSJobDetail{
+		{
+			ID:             "job-active",
+			SourceEndpoint: reader.DRSEndpoint{InstanceID: "rds-source"},
+			TargetEndpoint: reader.DRSEndpoint{InstanceID: "rds-target"},
+		},
+		{
+			ID:             "job-external",
+			SourceEndpoint: reader.DRSEndpoint{IP: "10.0.0.1"},
+			TargetEndpoint: reader.DRSEndpoint{InstanceID: "rds-target"},
+		},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(DRSJob), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "job-active", rs[0].ID())
+	assert.Equal(t, "rds-source", rs[0].Data().Get("source_db.0.instance_id"))
+	assert.Equal(t, "rds-target", rs[0].Data().Get("destination_db.0.instance_id"))
+
+	assert.Equal(t, "job-external", rs[1].ID())
+	assert.Equal(t, 0, rs[1].Data().Get("source_db.#"))
+	assert.Equal(t, "rds-target", rs[1].Data().Get("destination_db.0.instance_id"))
+
+	// The RDS instances are on the cache so
+	// they are not listed again
+	rds, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, rds, 2)
+}
+
+func TestASPolicies(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListASGroups(ctx, reader.Page{}).Return([]reader.ASGroup{
+		{ID: "group-1"},
+		{ID: "group-2"},
+	}, "", nil)
+	r.EXPECT().ListASPolicies(ctx, "group-1", reader.Page{}).Return([]reader.ASPolicy{
+		{ID: "policy-alarm", GroupID: "group-1", Type: reader.ASPolicyTypeAlarm, AlarmID: "al-1"},
+		{ID: "policy-scheduled", GroupID: "group-1", Type: "SCHEDULED"},
+	}, "", nil)
+	r.EXPECT().ListASPolicies(ctx, "group-2", reader.Page{}).Return([]reader.ASPolicy{
+		{ID: "policy-recurrence", GroupID: "group-2", Type: "RECURRENCE"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ASPolicy), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	assert.Equal(t, "policy-alarm", rs[0].ID())
+	assert.Equal(t, "group-1", rs[0].Data().Get("scaling_group_id"))
+	assert.Equal(t, "al-1", rs[0].Data().Get("alarm_id"))
+
+	assert.Equal(t, "policy-scheduled", rs[1].ID())
+	assert.Equal(t, "group-1", rs[1].Data().Get("scaling_group_id"))
+	assert.Equal(t, "", rs[1].Data().Get("alarm_id"))
+
+	assert.Equal(t, "policy-recurrence", rs[2].ID())
+	assert.Equal(t, "group-2", rs[2].Data().Get("scaling_group_id"))
+}
+
+func TestCESAlarmRules(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSMNTopics(ctx, reader.Page{}).Return([]reader.SMNTopic{
+		{URN: "urn:smn:cn-north-1:123456:ops"},
+	}, "", nil)
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{ID: "ecs-1"},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListCESAlarmRules(ctx, reader.Page{}).Return([]reader.CESAlarmRule{
+		{
+			ID:        "al-custom",
+			Namespace: "SYS.ECS",
+			Type:      "MULTI_INSTANCE",
+			Resources: []reader.CESAlarmResource{
+				{Dimensions: []reader.CESDimension{{Name: "instance_id", Value: "ecs-1"}}},
+				{Dimensions: []reader.CESDimension{{Name: "instance_id", Value: "ecs-unknown"}}},
+			},
+			AlarmNotifications: []reader.CESAlarmNotification{
+				{Type: "notification", NotificationList: []string{"urn:smn:cn-north-1:123456:ops", "urn:smn:cn-north-1:123456:other"}},
+			},
+		},
+		{
+			ID:        "al-system",
+			Namespace: "SYS.ECS",
+			Type:      reader.CESAlarmTypeSystemEvent,
+		},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(CESAlarmRule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "al-custom", rs[0].ID())
+	assert.Equal(t, "notification", rs[0].Data().Get("alarm_actions.0.type"))
+	assert.Equal(t, []interface{}{"urn:smn:cn-north-1:123456:ops"}, rs[0].Data().Get("alarm_actions.0.notification_list"))
+	assert.Equal(t, 1, rs[0].Data().Get("resources.#"))
+}
+
+func TestOBSBuckets(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{}).Return([]reader.OBSBucket{
+		{Name: "website", Location: "cn-north-1"},
+		{Name: "other-region", Location: "cn-south-1"},
+	}, "website", nil)
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{Marker: "website"}).Return([]reader.OBSBucket{
+		{Name: "plain", Location: "cn-north-1"},
+	}, "", nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "website").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "website").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "website").Return(reader.OBSBucketLogging{}, nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "plain").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "plain").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "plain").Return(reader.OBSBucketLogging{}, nil)
+
+	rs, err := p.Resources(ctx, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "website", rs[0].ID())
+	assert.Equal(t, "plain", rs[1].ID())
+	assert.Equal(t, 0, rs[1].Data().Get("logging.#"))
+}
+
+func TestOBSBucketsLogging(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{}).Return([]reader.OBSBucket{
+		{Name: "website", Location: "cn-north-1"},
+		{Name: "logs", Location: "cn-north-1"},
+	}, "", nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "website").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "website").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "website").Return(reader.OBSBucketLogging{
+		TargetBucket: "logs",
+		TargetPrefix: "website/",
+		Agency:       "obs-logging",
+	}, nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "logs").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "logs").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "logs").Return(reader.OBSBucketLogging{}, nil)
+
+	rs, err := p.Resources(ctx, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "website", rs[0].ID())
+	logging := rs[0].Data().Get("logging").(*schema.Set).List()
+	require.Len(t, logging, 1)
+	assert.Equal(t, map[string]interface{}{
+		"target_bucket": "logs",
+		"target_prefix": "website/",
+		"agency":        "obs-logging",
+	}, logging[0])
+
+	// The target bucket is resolved from the cache
+	// so the buckets are only listed once
+	cached, err := cacheOBSBuckets(ctx, p, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, cached, 2)
+
+	assert.Equal(t, "logs", rs[1].ID())
+	assert.Equal(t, 0, rs[1].Data().Get("logging.#"))
+}
+
+func TestOBSBucketsStorage(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{}).Return([]reader.OBSBucket{
+		{Name: "requester-pays", Location: "cn-north-1"},
+		{Name: "plain", Location: "cn-north-1"},
+	}, "", nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "requester-pays").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "requester-pays").Return(reader.OBSBucketStorage{
+		Quota:         1073741824,
+		RequesterPays: true,
+	}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "requester-pays").Return(reader.OBSBucketLogging{}, nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "plain").Return(reader.OBSBucketMetadata{}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "plain").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "plain").Return(reader.OBSBucketLogging{}, nil)
+
+	rs, err := p.Resources(ctx, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	// The requester-pays bucket is still imported
+	assert.Equal(t, "requester-pays", rs[0].ID())
+	assert.Equal(t, 1073741824, rs[0].Data().Get("quota"))
+
+	assert.Equal(t, "plain", rs[1].ID())
+	_, ok := rs[1].Data().GetOk("quota")
+	assert.False(t, ok)
+}
+
+func TestOBSBucketsMetadata(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{}).Return([]reader.OBSBucket{
+		{Name: "parallel", Location: "cn-north-1", Type: "POSIX"},
+		{Name: "multi-az", Location: "cn-north-1", Type: "OBJECT"},
+	}, "", nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "parallel").Return(reader.OBSBucketMetadata{ParallelFS: true}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "parallel").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "parallel").Return(reader.OBSBucketLogging{}, nil)
+	r.EXPECT().GetOBSBucketMetadata(ctx, "multi-az").Return(reader.OBSBucketMetadata{MultiAZ: true}, nil)
+	r.EXPECT().GetOBSBucketStorage(ctx, "multi-az").Return(reader.OBSBucketStorage{}, nil)
+	r.EXPECT().GetOBSBucketLogging(ctx, "multi-az").Return(reader.OBSBucketLogging{}, nil)
+
+	rs, err := p.Resources(ctx, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "parallel", rs[0].ID())
+	assert.Equal(t, true, rs[0].Data().Get("parallel_fs"))
+	assert.Equal(t, false, rs[0].Data().Get("multi_az"))
+
+	assert.Equal(t, "multi-az", rs[1].ID())
+	assert.Equal(t, false, rs[1].Data().Get("parallel_fs"))
+	assert.Equal(t, true, rs[1].Data().Get("multi_az"))
+}
+
+func TestEVSVolumes(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return([]reader.EVSVolume{
+		{ID: "vol-system", Bootable: "true", Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vda"}}},
+		{ID: "vol-data", Bootable: "false", Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vdb"}}},
+	}, "2", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{Marker: "2"}).Return([]reader.EVSVolume{
+		{ID: "vol-image", Bootable: "true"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(EVSVolume), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "vol-data", rs[0].ID())
+	assert.Equal(t, "vol-image", rs[1].ID())
+}
+
+func TestComputeVolumeAttaches(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed with the disks of the instances and for the volumes
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return([]reader.EVSVolume{
+		{ID: "vol-data", Bootable: "false", Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vdb"}}},
+		{ID: "vol-shared", Bootable: "false", Multiattach: true, Attachments: []reader.EVSVolumeAttachment{
+			{ServerID: "ecs-2", Device: "/dev/vdc"},
+			{ServerID: "ecs-1", Device: "/dev/vdc"},
+			{ServerID: "ecs-unknown", Device: "/dev/vdb"},
+		}},
+	}, "", nil).Times(2)
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{ID: "ecs-1"},
+		{ID: "ecs-2"},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(ComputeVolumeAttach), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "ecs-1/vol-shared", rs[0].ID())
+	assert.Equal(t, "ecs-1", rs[0].Data().Get("instance_id"))
+	assert.Equal(t, "vol-shared", rs[0].Data().Get("volume_id"))
+	assert.Equal(t, "/dev/vdc", rs[0].Data().Get("device"))
+	assert.Equal(t, "ecs-2/vol-shared", rs[1].ID())
+
+	// The volumes are read from the cache
+	vs, err := p.Resources(ctx, string(EVSVolume), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+
+	assert.Equal(t, false, vs[0].Data().Get("multiattach"))
+	assert.Equal(t, true, vs[1].Data().Get("multiattach"))
+}
+
+func TestOBSBucketACLs(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	bucket := func(name string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal(name),
+			"bucket": cty.StringVal(name),
+			"acl":    cty.StringVal("public-read"),
+		})
+	}
+
+	// Without the ACLs imported the inline ACL is kept
+	v, err := p.FixResource(string(OBSBucket), bucket("website"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("public-read"), v.GetAttr("acl"))
+
+	r.EXPECT().ListOBSBuckets(ctx, reader.Page{}).Return([]reader.OBSBucket{
+		{Name: "website", Location: "cn-north-1"},
+		{Name: "other-region", Location: "cn-south-1"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(OBSBucketACL), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "website", rs[0].ID())
+	assert.Equal(t, "website", rs[0].Data().Get("bucket"))
+
+	// The buckets are cached so they are only listed once
+	_, err = cacheOBSBuckets(ctx, p, string(OBSBucket), &filter.Filter{})
+	require.NoError(t, err)
+
+	v, err = p.FixResource(string(OBSBucket), bucket("website"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.NullVal(cty.String), v.GetAttr("acl"))
+
+	v, err = p.FixResource(string(OBSBucket), bucket("other"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("public-read"), v.GetAttr("acl"))
+}
+
+func TestIncludeDefaults(t *testing.T) {
+	sgs := []reader.SecurityGroup{
+		{ID: "sg-default", Name: "default"},
+		{ID: "sg-web", Name: "web"},
+	}
+	rts := []reader.RouteTable{
+		{ID: "rt-default", Default: true, VpcID: "vpc-1"},
+		{ID: "rt-custom", VpcID: "vpc-1"},
+	}
+
+	tcs := []struct {
+		Name            string
+		IncludeDefaults bool
+		SecurityGroups  []string
+		RouteTables     []string
+	}{
+		{
+			Name:           "Excluded",
+			SecurityGroups: []string{"sg-web"},
+			RouteTables:    []string{"rt-custom"},
+		},
+		{
+			Name:            "Included",
+			IncludeDefaults: true,
+			SecurityGroups:  []string{"sg-default", "sg-web"},
+			RouteTables:     []string{"rt-default", "rt-custom"},
+		},
+	}
+
+	ids := func(rs []provider.Resource) []string {
+		res := make([]string, 0, len(rs))
+		for _, r := range rs {
+			res = append(res, r.ID())
+		}
+		return res
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			p.options.IncludeDefaults = tc.IncludeDefaults
+
+			r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return(sgs, "", nil)
+			r.EXPECT().ListRouteTables(ctx, reader.Page{}).Return(rts, "", nil)
+
+			rs, err := p.Resources(ctx, string(SecurityGroup), &filter.Filter{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.SecurityGroups, ids(rs))
+
+			rs, err = p.Resources(ctx, string(VPCRouteTable), &filter.Filter{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.RouteTables, ids(rs))
+			assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+		})
+	}
+}
+
+func TestGaussDBOpenGaussInstances(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "db"}}, "", nil)
+
+	distributed := reader.GaussDBOpenGaussInstance{
+		ID:              "gauss-distributed",
+		Type:            "Enterprise",
+		VPCID:           "vpc-1",
+		SubnetID:        "subnet-1",
+		SecurityGroupID: "sg-1",
+		Nodes: []reader.GaussDBNode{
+			{ID: "cn-1", Role: "cn"},
+			{ID: "cn-2", Role: "cn"},
+			{ID: "dn-1", Role: "master"},
+			{ID: "dn-2", Role: "slave"},
+		},
+	}
+	r.EXPECT().ListGaussDBOpenGaussInstances(ctx, reader.Page{}).Return([]reader.GaussDBOpenGaussInstance{
+		distributed,
+		{ID: "gauss-other-vpc", Type: "Ha", VPCID: "vpc-2", SubnetID: "subnet-2", SecurityGroupID: "sg-1"},
+	}, "2", nil)
+	r.EXPECT().ListGaussDBOpenGaussInstances(ctx, reader.Page{Marker: "2"}).Return([]reader.GaussDBOpenGaussInstance{
+		distributed,
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(GaussDBOpenGauss), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "gauss-distributed", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[0].Data().Get("security_group_id"))
+
+	assert.Equal(t, "gauss-other-vpc", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, "", rs[1].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[1].Data().Get("security_group_id"))
+
+	// The network resources are read only once
+	_, err = p.Resources(ctx, string(VPC), &filter.Filter{})
+	require.NoError(t, err)
+}
+
+func TestSFSFileSystems(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSFSShares(ctx, reader.Page{}).Return([]reader.SFSShare{
+		{ID: "sfs-1", Status: "available", ShareProto: "NFS"},
+		{ID: "sfs-deleting", Status: "deleting", ShareProto: "NFS"},
+	}, "", nil)
+	r.EXPECT().ListSFSAccessRules(ctx, "sfs-1").Return([]reader.SFSAccessRule{
+		{ID: "rule-vpc", AccessType: reader.SFSAccessTypeVPC, AccessTo: "vpc-1"},
+		{ID: "rule-other-vpc", AccessType: reader.SFSAccessTypeVPC, AccessTo: "vpc-2"},
+	}, nil).Times(2)
+
+	rs, err := p.Resources(ctx, string(SFSFileSystem), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "sfs-1", rs[0].ID())
+	assert.Equal(t, string(SFSFileSystem), rs[0].Type())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("access_to"))
+
+	rs, err = p.Resources(ctx, string(SFSAccessRule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "sfs-1/rule-vpc", rs[0].ID())
+	assert.Equal(t, "sfs-1", rs[0].Data().Get("sfs_id"))
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("access_to"))
+
+	assert.Equal(t, "sfs-1/rule-other-vpc", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("access_to"))
+
+	// SFS Turbo is a different service with its own API and resource
+	_, err = ResourceTypeString("huaweicloud_sfs_turbo")
+	assert.Error(t, err)
+}
+
+func TestEIPsBandwidth(t *testing.T) {
+	t.Run("Read", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListEIPs(ctx, reader.Page{}).Return([]reader.EIP{
+			{ID: "eip-dedicated", PublicIPAddress: "1.1.1.1", PortID: "port-ecs-1", BandwidthID: "bw-1", BandwidthName: "ecs-1-bandwidth", BandwidthShareType: "PER", BandwidthSize: 5},
+			{ID: "eip-shared", PublicIPAddress: "2.2.2.2", PortID: "port-ecs-2", BandwidthID: "bw-shared", BandwidthName: "shared-bandwidth", BandwidthShareType: "WHOLE", BandwidthSize: 100},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(EIP), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "eip-dedicated", rs[0].ID())
+		assert.Equal(t, "PER", rs[0].Data().Get("bandwidth.0.share_type"))
+		assert.Equal(t, "ecs-1-bandwidth", rs[0].Data().Get("bandwidth.0.name"))
+		assert.Equal(t, 5, rs[0].Data().Get("bandwidth.0.size"))
+		assert.Equal(t, "", rs[0].Data().Get("bandwidth.0.id"))
+
+		assert.Equal(t, "eip-shared", rs[1].ID())
+		assert.Equal(t, "WHOLE", rs[1].Data().Get("bandwidth.0.share_type"))
+		assert.Equal(t, "bw-shared", rs[1].Data().Get("bandwidth.0.id"))
+		assert.Equal(t, "", rs[1].Data().Get("bandwidth.0.name"))
+		assert.Equal(t, 0, rs[1].Data().Get("bandwidth.0.size"))
+	})
+
+	t.Run("Fix", func(t *testing.T) {
+		var (
+			nullString = cty.NullVal(cty.String)
+			nullNumber = cty.NullVal(cty.Number)
+		)
+
+		eip := func(shareType, id, name string, size, chargeMode cty.Value) cty.Value {
+			return cty.ObjectVal(map[string]cty.Value{
+				"id":      cty.StringVal("eip-1"),
+				"port_id": cty.StringVal("port-ecs-1"),
+				"bandwidth": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"share_type":  cty.StringVal(shareType),
+					"id":          optionalString(id),
+					"name":        optionalString(name),
+					"size":        size,
+					"charge_mode": chargeMode,
+				})}),
+			})
+		}
+
+		tcs := []struct {
+			Name   string
+			Value  cty.Value
+			Expect cty.Value
+		}{
+			{
+				Name:   "Dedicated",
+				Value:  eip("PER", "bw-1", "ecs-1-bandwidth", cty.NumberIntVal(5), cty.StringVal("traffic")),
+				Expect: eip("PER", "", "ecs-1-bandwidth", cty.NumberIntVal(5), cty.StringVal("traffic")),
+			},
+			{
+				Name:   "Shared",
+				Value:  eip("WHOLE", "bw-shared", "shared-bandwidth", cty.NumberIntVal(100), cty.StringVal("bandwidth")),
+				Expect: eip("WHOLE", "bw-shared", "", nullNumber, nullString),
+			},
+			{
+				Name:   "UnknownShareType",
+				Value:  eip("OTHER", "bw-1", "bandwidth", cty.NumberIntVal(5), nullString),
+				Expect: eip("OTHER", "bw-1", "bandwidth", cty.NumberIntVal(5), nullString),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.Name, func(t *testing.T) {
+				v := fixEIPBandwidth(tc.Value)
+				assert.True(t, tc.Expect.RawEquals(v), v.GoString())
+			})
+		}
+	})
+}
+
+// optionalString returns the cty.String of s
+// or a null one if s is empty
+func optionalString(s string) cty.Value {
+	if s == "" {
+		return cty.NullVal(cty.String)
+	}
+	return cty.StringVal(s)
+}
+
+func TestEIPAssociates(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListEIPs(ctx, reader.Page{}).Return([]reader.EIP{
+		{ID: "eip-instance", PublicIPAddress: "1.1.1.1", PortID: "port-instance"},
+		{ID: "eip-vip", PublicIPAddress: "2.2.2.2", PortID: "port-vip"},
+		{ID: "eip-other-instance", PublicIPAddress: "4.4.4.4", PortID: "port-other-instance"},
+		{ID: "eip-unassociated", PublicIPAddress: "3.3.3.3"},
+	}, "", nil)
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{{ID: "ecs-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListPorts(ctx, reader.Page{}).Return([]reader.Port{
+		{ID: "port-instance", NetworkID: "subnet-1", DeviceID: "ecs-1", DeviceOwner: "compute:cn-north-1a"},
+		{ID: "port-other-instance", NetworkID: "subnet-1", DeviceID: "ecs-2", DeviceOwner: "compute:cn-north-1a"},
+		{ID: "port-vip", NetworkID: "subnet-2", DeviceOwner: "neutron:VIP_PORT"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(EIPAssociate), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "eip-instance", rs[0].ID())
+	assert.Equal(t, "1.1.1.1", rs[0].Data().Get("public_ip"))
+	assert.Equal(t, "port-instance", rs[0].Data().Get("port_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("network_id"))
+
+	assert.Equal(t, "eip-vip", rs[1].ID())
+	assert.Equal(t, "2.2.2.2", rs[1].Data().Get("public_ip"))
+	assert.Equal(t, "port-vip", rs[1].Data().Get("port_id"))
+	assert.Equal(t, "subnet-2", rs[1].Data().Get("network_id"))
+}
+
+func TestEnterpriseProjects(t *testing.T) {
+	t.Run("All", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.AllEnterpriseProjects = true
+
+		r.EXPECT().ListEnterpriseProjects(ctx, reader.Page{}).Return([]reader.EnterpriseProject{
+			{ID: "0", Name: "default"},
+			{ID: "eps-1", Name: "production"},
+		}, "", nil).Times(1)
+		r.EXPECT().ListVPCs(ctx, reader.Page{EnterpriseProjectID: "0"}).Return([]reader.VPC{{ID: "vpc-default"}}, "", nil)
+		r.EXPECT().ListVPCs(ctx, reader.Page{EnterpriseProjectID: "eps-1"}).Return([]reader.VPC{{ID: "vpc-production"}}, "", nil)
+		r.EXPECT().ListServers(ctx, reader.Page{EnterpriseProjectID: "0"}).Return(nil, "", nil)
+		r.EXPECT().ListServers(ctx, reader.Page{EnterpriseProjectID: "eps-1"}).Return([]reader.Server{{ID: "ecs-1"}}, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+		rs, err := p.Resources(ctx, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "vpc-default", rs[0].ID())
+		assert.Equal(t, "0", rs[0].Data().Get("enterprise_project_id"))
+		assert.Equal(t, "vpc-production", rs[1].ID())
+		assert.Equal(t, "eps-1", rs[1].Data().Get("enterprise_project_id"))
+
+		rs, err = p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		assert.Equal(t, "ecs-1", rs[0].ID())
+		assert.Equal(t, "eps-1", rs[0].Data().Get("enterprise_project_id"))
+	})
+
+	t.Run("Scoped", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.EnterpriseProjectID = "eps-1"
+
+		r.EXPECT().ListEIPs(ctx, reader.Page{EnterpriseProjectID: "eps-1"}).Return([]reader.EIP{{ID: "eip-1", PublicIPAddress: "1.1.1.1"}}, "", nil)
+
+		rs, err := p.Resources(ctx, string(EIP), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		assert.Equal(t, "eps-1", rs[0].Data().Get("enterprise_project_id"))
+	})
+
+	t.Run("UnsupportedTypes", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.AllEnterpriseProjects = true
+
+		// The types that can not be scoped fail instead
+		// of importing the resources of all the projects
+		assert.ElementsMatch(t, []string{string(ComputeInstance), string(VPC), string(EIP), string(SecurityGroup)}, p.ResourceTypes())
+		assert.True(t, p.HasResourceType(string(VPC)))
+		assert.False(t, p.HasResourceType(string(RDSInstance)))
+
+		_, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+		require.Error(t, err)
+		assert.Equal(t, `the resource type "huaweicloud_rds_instance" can not be scoped by enterprise project`, err.Error())
+
+		_, err = p.ResourcesBatch(ctx, []string{string(VPC), string(RDSInstance)}, &filter.Filter{})
+		require.Error(t, err)
+	})
+}
+
+func TestInstancesTags(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{
+			ID:   "ecs-1",
+			Tags: []string{"env=prod", "owner=cycloid", "empty"},
+			Metadata: map[string]string{
+				"env":               "dev",
+				"team":              "infra",
+				"charging_mode":     "0",
+				"metering.image_id": "img-1",
+			},
+		},
+		{ID: "ecs-2", Metadata: map[string]string{"image_name": "ubuntu"}},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	tags := map[string]interface{}{"env": "prod", "owner": "cycloid", "empty": "", "team": "infra"}
+	assert.Equal(t, tags, rs[0].Data().Get("tags"))
+	assert.Equal(t, map[string]interface{}{}, rs[1].Data().Get("tags"))
+
+	v, err := p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("ecs-1"),
+		"tags": cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod"), "owner": cty.StringVal("cycloid")}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, cty.MapVal(map[string]cty.Value{
+		"env":   cty.StringVal("prod"),
+		"owner": cty.StringVal("cycloid"),
+		"empty": cty.StringVal(""),
+		"team":  cty.StringVal("infra"),
+	}), v.GetAttr("tags"))
+
+	v, err = p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("ecs-2"),
+		"tags": cty.MapValEmpty(cty.String),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, cty.MapValEmpty(cty.String), v.GetAttr("tags"))
+}
+
+func TestWorkspaceDesktops(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "desktops"}}, "", nil)
+
+	r.EXPECT().ListWorkspaceDesktops(ctx, reader.Page{}).Return([]reader.WorkspaceDesktop{
+		{
+			ID:       "desktop-1",
+			Status:   "ACTIVE",
+			UserName: "alice",
+			Addresses: map[string][]reader.WorkspaceDesktopAddress{
+				"subnet-1": {{Address: "192.168.0.10"}},
+				"subnet-2": {{Address: "192.168.1.10"}},
+			},
+			SecurityGroups: []reader.WorkspaceDesktopSecurityGroup{{ID: "sg-1"}, {ID: "sg-2"}},
+		},
+		{ID: "desktop-deleting", Status: "ACTIVE", TaskStatus: "deleting", UserName: "bob"},
+	}, "1", nil)
+	r.EXPECT().ListWorkspaceDesktops(ctx, reader.Page{Marker: "1"}).Return([]reader.WorkspaceDesktop{
+		{ID: "desktop-other-network", Status: "SHUTOFF", UserName: "carol", Addresses: map[string][]reader.WorkspaceDesktopAddress{
+			"subnet-2": {{Address: "192.168.1.11"}},
+		}},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(WorkspaceDesktop), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "desktop-1", rs[0].ID())
+	assert.Equal(t, "alice", rs[0].Data().Get("user_name"))
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("nic.0.network_id"))
+	assert.Equal(t, 1, rs[0].Data().Get("nic.#"))
+	assert.Equal(t, []interface{}{"sg-1"}, rs[0].Data().Get("security_groups").(*schema.Set).List())
+
+	assert.Equal(t, "desktop-other-network", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, 0, rs[1].Data().Get("nic.#"))
+}
+
+func TestMaxPerType(t *testing.T) {
+	t.Run("StopsPaginating", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		// The next page is not read as the cap is reached on the first one
+		r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}, {ID: "vpc-2"}}, "2", nil)
+
+		rs, err := p.Resources(ctx, string(VPC), &filter.Filter{MaxPerType: 2})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "vpc-1", rs[0].ID())
+		assert.Equal(t, "vpc-2", rs[1].ID())
+	})
+
+	t.Run("TruncatesLastPage", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return([]reader.RDSInstance{{ID: "rds-1"}, {ID: "rds-2"}}, "2", nil)
+		r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+		r.EXPECT().ListRDSInstances(ctx, reader.Page{Marker: "2"}).Return([]reader.RDSInstance{{ID: "rds-3"}, {ID: "rds-4"}}, "4", nil)
+
+		rs, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{MaxPerType: 3})
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+		assert.Equal(t, "rds-3", rs[2].ID())
+	})
+
+	t.Run("StopsNestedListing", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListASGroups(ctx, reader.Page{}).Return([]reader.ASGroup{{ID: "group-1"}, {ID: "group-2"}}, "", nil)
+		r.EXPECT().ListASPolicies(ctx, "group-1", reader.Page{}).Return([]reader.ASPolicy{{ID: "policy-1"}}, "", nil)
+
+		rs, err := p.Resources(ctx, string(ASPolicy), &filter.Filter{MaxPerType: 1})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "policy-1", rs[0].ID())
+	})
+
+	// The readers listing everything at once are called directly,
+	// as the provider truncates the resources they return
+	t.Run("DBSSInstances", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListVPCs(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListDBSSInstances(ctx).Return([]reader.DBSSInstance{
+			{ID: "dbss-1", Status: "ACTIVE"},
+			{ID: "dbss-2", Status: "ACTIVE"},
+			{ID: "dbss-3", Status: "ACTIVE"},
+		}, nil)
+
+		rs, err := dbssInstances(ctx, p, string(DBSSInstance), &filter.Filter{MaxPerType: 2})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "dbss-2", rs[1].ID())
+	})
+
+	t.Run("DWSClusters", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListVPCs(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return(nil, "", nil)
+		r.EXPECT().ListDWSClusters(ctx).Return([]reader.DWSCluster{
+			{ID: "dws-1", Status: "AVAILABLE"},
+			{ID: "dws-2", Status: "AVAILABLE"},
+		}, nil)
+
+		rs, err := dwsClusters(ctx, p, string(DWSCluster), &filter.Filter{MaxPerType: 1})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "dws-1", rs[0].ID())
+	})
+
+	t.Run("ComputeVolumeAttaches", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return([]reader.EVSVolume{
+			{ID: "vol-shared-1", Bootable: "false", Multiattach: true, Attachments: []reader.EVSVolumeAttachment{
+				{ServerID: "ecs-1", Device: "/dev/vdb"},
+				{ServerID: "ecs-2", Device: "/dev/vdb"},
+			}},
+			{ID: "vol-shared-2", Bootable: "false", Multiattach: true, Attachments: []reader.EVSVolumeAttachment{
+				{ServerID: "ecs-1", Device: "/dev/vdc"},
+			}},
+		}, "", nil).Times(2)
+		r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{{ID: "ecs-1"}, {ID: "ecs-2"}}, "", nil)
+		r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+
+		rs, err := computeVolumeAttaches(ctx, p, string(ComputeVolumeAttach), &filter.Filter{MaxPerType: 2})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "ecs-1/vol-shared-1", rs[0].ID())
+		assert.Equal(t, "ecs-2/vol-shared-1", rs[1].ID())
+	})
+}
+
+func TestInstancesPowerAction(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{ID: "ecs-running", Status: "ACTIVE"},
+		{ID: "ecs-stopped", Status: "SHUTOFF"},
+		{ID: "ecs-rebooting", Status: "REBOOT"},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	assert.Equal(t, "ON", rs[0].Data().Get("power_action"))
+	assert.Equal(t, "OFF", rs[1].Data().Get("power_action"))
+	assert.Equal(t, "", rs[2].Data().Get("power_action"))
+
+	instance := func(id, status string, action cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":           cty.StringVal(id),
+			"status":       cty.StringVal(status),
+			"power_action": action,
+		})
+	}
+
+	for _, tc := range []struct {
+		name   string
+		in     cty.Value
+		action cty.Value
+	}{
+		{name: "Running", in: instance("ecs-running", "ACTIVE", cty.NullVal(cty.String)), action: cty.StringVal("ON")},
+		{name: "Stopped", in: instance("ecs-stopped", "SHUTOFF", cty.StringVal("")), action: cty.StringVal("OFF")},
+		{name: "Transitory", in: instance("ecs-rebooting", "REBOOT", cty.NullVal(cty.String)), action: cty.NullVal(cty.String)},
+		{name: "AlreadySet", in: instance("ecs-stopped", "SHUTOFF", cty.StringVal("FORCE-OFF")), action: cty.StringVal("FORCE-OFF")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := p.FixResource(string(ComputeInstance), tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.action, v.GetAttr("power_action"))
+		})
+	}
+}
+
+func TestInstancesChargingMode(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{ID: "ecs-postpaid", Metadata: map[string]string{"charging_mode": "0"}},
+		{ID: "ecs-prepaid", Metadata: map[string]string{"charging_mode": "1"}},
+		{ID: "ecs-spot", Metadata: map[string]string{"charging_mode": "2"}},
+		{ID: "ecs-spot-duration", Metadata: map[string]string{"charging_mode": "2"}},
+		{ID: "ecs-no-metadata"},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().GetServerMarketInfo(ctx, "ecs-spot").Return(reader.ServerMarketInfo{
+		MarketType:  "spot",
+		SpotOptions: reader.ServerSpotOptions{SpotPrice: "0.25"},
+	}, nil)
+	r.EXPECT().GetServerMarketInfo(ctx, "ecs-spot-duration").Return(reader.ServerMarketInfo{
+		MarketType:  "spot",
+		SpotOptions: reader.ServerSpotOptions{SpotPrice: "0.25", SpotDurationHours: 2, SpotDurationCount: 3},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 5)
+
+	assert.Equal(t, "postPaid", rs[0].Data().Get("charging_mode"))
+	assert.Equal(t, "prePaid", rs[1].Data().Get("charging_mode"))
+	assert.Equal(t, "spot", rs[2].Data().Get("charging_mode"))
+	assert.Equal(t, "0.25", rs[2].Data().Get("spot_maximum_price"))
+	assert.Equal(t, 0, rs[2].Data().Get("spot_duration"))
+	assert.Equal(t, "spot", rs[3].Data().Get("charging_mode"))
+	assert.Equal(t, "", rs[3].Data().Get("spot_maximum_price"))
+	assert.Equal(t, 2, rs[3].Data().Get("spot_duration"))
+	assert.Equal(t, 3, rs[3].Data().Get("spot_duration_count"))
+	assert.Equal(t, "postPaid", rs[4].Data().Get("charging_mode"))
+
+	instance := func(id, mode string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":                  cty.StringVal(id),
+			"charging_mode":       cty.StringVal(mode),
+			"spot_maximum_price":  cty.StringVal(""),
+			"spot_duration":       cty.NumberIntVal(0),
+			"spot_duration_count": cty.NumberIntVal(0),
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		in       cty.Value
+		mode     cty.Value
+		price    cty.Value
+		duration cty.Value
+		count    cty.Value
+	}{
+		{name: "PostPaid", in: instance("ecs-postpaid", "postPaid"), mode: cty.StringVal("postPaid"), price: cty.NullVal(cty.String), duration: cty.NullVal(cty.Number), count: cty.NullVal(cty.Number)},
+		{name: "PrePaid", in: instance("ecs-prepaid", "prePaid"), mode: cty.StringVal("prePaid"), price: cty.NullVal(cty.String), duration: cty.NullVal(cty.Number), count: cty.NullVal(cty.Number)},
+		{name: "Spot", in: instance("ecs-spot", "postPaid"), mode: cty.StringVal("spot"), price: cty.StringVal("0.25"), duration: cty.NullVal(cty.Number), count: cty.NullVal(cty.Number)},
+		{name: "SpotDuration", in: instance("ecs-spot-duration", "spot"), mode: cty.StringVal("spot"), price: cty.NullVal(cty.String), duration: cty.NumberIntVal(2), count: cty.NumberIntVal(3)},
+		{name: "NotRead", in: instance("ecs-other", "postPaid"), mode: cty.StringVal("postPaid"), price: cty.StringVal(""), duration: cty.NumberIntVal(0), count: cty.NumberIntVal(0)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := p.FixResource(string(ComputeInstance), tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.mode, v.GetAttr("charging_mode"))
+			assert.Equal(t, tc.price, v.GetAttr("spot_maximum_price"))
+			assert.Equal(t, tc.duration, v.GetAttr("spot_duration"))
+			assert.Equal(t, tc.count, v.GetAttr("spot_duration_count"))
+		})
+	}
+}
+
+func TestInstancesSchedulerHints(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{
+		{ID: "ecs-deh", SchedulerHints: reader.ServerSchedulerHints{Tenancy: []string{"dedicated"}, DedicatedHostID: []string{"deh-1"}}},
+		{ID: "ecs-deh-without-tenancy", SchedulerHints: reader.ServerSchedulerHints{DedicatedHostID: []string{"deh-2"}}},
+		{ID: "ecs-group", SchedulerHints: reader.ServerSchedulerHints{Group: []string{"group-1"}}},
+		{ID: "ecs-fault-domain", SchedulerHints: reader.ServerSchedulerHints{Group: []string{"group-fd"}, FaultDomain: []string{"cn-north-1a-fd1"}}},
+		{ID: "ecs-shared"},
+	}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 5)
+
+	for i, h := range []map[string]interface{}{
+		{"group": "", "fault_domain": "", "tenancy": "dedicated", "deh_id": "deh-1"},
+		{"group": "", "fault_domain": "", "tenancy": "dedicated", "deh_id": "deh-2"},
+		{"group": "group-1", "fault_domain": "", "tenancy": "", "deh_id": ""},
+		{"group": "group-fd", "fault_domain": "cn-north-1a-fd1", "tenancy": "", "deh_id": ""},
+	} {
+		sh := rs[i].Data().Get("scheduler_hints").(*schema.Set).List()
+		require.Len(t, sh, 1, rs[i].ID())
+		assert.Equal(t, h, sh[0], rs[i].ID())
+	}
+	assert.Equal(t, 0, rs[4].Data().Get("scheduler_hints.#"))
+
+	hints := cty.Object(map[string]cty.Type{
+		"group":        cty.String,
+		"fault_domain": cty.String,
+		"tenancy":      cty.String,
+		"deh_id":       cty.String,
+	})
+	readHints := func(group cty.Value) cty.Value {
+		return cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"group":        group,
+			"fault_domain": cty.NullVal(cty.String),
+			"tenancy":      cty.NullVal(cty.String),
+			"deh_id":       cty.NullVal(cty.String),
+		})})
+	}
+
+	tcs := []struct {
+		Name   string
+		ID     string
+		Hints  cty.Value
+		Expect cty.Value
+	}{
+		{
+			Name:  "DeHWithGroup",
+			ID:    "ecs-deh",
+			Hints: readHints(cty.StringVal("group-2")),
+			Expect: cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"group":        cty.StringVal("group-2"),
+				"fault_domain": cty.NullVal(cty.String),
+				"tenancy":      cty.StringVal("dedicated"),
+				"deh_id":       cty.StringVal("deh-1"),
+			})}),
+		},
+		{
+			Name:  "DeHWithoutTenancy",
+			ID:    "ecs-deh-without-tenancy",
+			Hints: cty.SetValEmpty(hints),
+			Expect: cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"group":        cty.NullVal(cty.String),
+				"fault_domain": cty.NullVal(cty.String),
+				"tenancy":      cty.StringVal("dedicated"),
+				"deh_id":       cty.StringVal("deh-2"),
+			})}),
+		},
+		{
+			Name:   "Group",
+			ID:     "ecs-group",
+			Hints:  readHints(cty.StringVal("group-1")),
+			Expect: readHints(cty.StringVal("group-1")),
+		},
+		{
+			Name:  "FaultDomain",
+			ID:    "ecs-fault-domain",
+			Hints: readHints(cty.StringVal("group-fd")),
+			Expect: cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"group":        cty.StringVal("group-fd"),
+				"fault_domain": cty.StringVal("cn-north-1a-fd1"),
+				"tenancy":      cty.NullVal(cty.String),
+				"deh_id":       cty.NullVal(cty.String),
+			})}),
+		},
+		{
+			Name:   "WithoutHints",
+			ID:     "ecs-shared",
+			Hints:  cty.SetValEmpty(hints),
+			Expect: cty.SetValEmpty(hints),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+				"id":              cty.StringVal(tc.ID),
+				"scheduler_hints": tc.Hints,
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expect, v.GetAttr("scheduler_hints"))
+		})
+	}
+}
+
+func TestInstancesDisks(t *testing.T) {
+	volumes := []reader.EVSVolume{
+		{ID: "vol-system", Bootable: "true", VolumeType: "SSD", Size: 40, Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vda"}}},
+		{ID: "vol-data-2", VolumeType: "SAS", Size: 200, Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vdc"}}},
+		{ID: "vol-data-1", VolumeType: "GPSSD", Size: 100, Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vdb"}}},
+		{ID: "vol-shared", VolumeType: "SSD", Size: 10, Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vdd"}, {ServerID: "ecs-2", Device: "/dev/vdb"}}},
+		{ID: "vol-detached", VolumeType: "SAS", Size: 50},
+	}
+
+	tcs := []struct {
+		Name            string
+		InlineDataDisks bool
+		DataDisks       []interface{}
+		Volumes         []string
+	}{
+		{
+			Name:    "Separate",
+			Volumes: []string{"vol-data-2", "vol-data-1", "vol-shared", "vol-detached"},
+		},
+		{
+			Name:            "Inline",
+			InlineDataDisks: true,
+			DataDisks: []interface{}{
+				map[string]interface{}{"type": "GPSSD", "size": 100},
+				map[string]interface{}{"type": "SAS", "size": 200},
+			},
+			Volumes: []string{"vol-shared", "vol-detached"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			p.options.InlineDataDisks = tc.InlineDataDisks
+
+			r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{{ID: "ecs-1"}, {ID: "ecs-2"}}, "", nil)
+			r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+			// The volumes are listed once for all the
+			// instances and once for the EVS volumes
+			r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(volumes, "", nil).Times(2)
+
+			rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+			require.NoError(t, err)
+			require.Len(t, rs, 2)
+
+			// The system disk is always inline
+			assert.Equal(t, "SSD", rs[0].Data().Get("system_disk_type"))
+			assert.Equal(t, 40, rs[0].Data().Get("system_disk_size"))
+
+			var dds []interface{}
+			for _, dd := range rs[0].Data().Get("data_disks").([]interface{}) {
+				m := dd.(map[string]interface{})
+				dds = append(dds, map[string]interface{}{"type": m["type"], "size": m["size"]})
+			}
+			assert.Equal(t, tc.DataDisks, dds)
+			assert.Equal(t, 0, rs[1].Data().Get("data_disks.#"))
+
+			rs, err = p.Resources(ctx, string(EVSVolume), &filter.Filter{})
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(rs))
+			for _, r := range rs {
+				ids = append(ids, r.ID())
+			}
+			assert.Equal(t, tc.Volumes, ids)
+		})
+	}
+}
+
+func TestInstancesEIPs(t *testing.T) {
+	tcs := []struct {
+		Name         string
+		InlineEIPs   bool
+		EIPID        string
+		Associations []string
+	}{
+		{
+			Name:         "Association",
+			Associations: []string{"eip-single", "eip-multi-1", "eip-multi-2"},
+		},
+		{
+			Name:         "Inline",
+			InlineEIPs:   true,
+			EIPID:        "eip-single",
+			Associations: []string{"eip-multi-1", "eip-multi-2"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			p.options.InlineEIPs = tc.InlineEIPs
+
+			r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{{ID: "ecs-single"}, {ID: "ecs-multi"}}, "", nil)
+			r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+			r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+			r.EXPECT().ListEIPs(ctx, reader.Page{}).Return([]reader.EIP{
+				{ID: "eip-single", PublicIPAddress: "1.1.1.1", PortID: "port-single"},
+				{ID: "eip-multi-1", PublicIPAddress: "2.2.2.2", PortID: "port-multi-1"},
+				{ID: "eip-multi-2", PublicIPAddress: "3.3.3.3", PortID: "port-multi-2"},
+			}, "", nil)
+			ports := r.EXPECT().ListPorts(ctx, reader.Page{}).Return([]reader.Port{
+				{ID: "port-single", NetworkID: "subnet-1", DeviceID: "ecs-single", DeviceOwner: "compute:cn-north-1a"},
+				{ID: "port-multi-1", NetworkID: "subnet-1", DeviceID: "ecs-multi", DeviceOwner: "compute:cn-north-1a"},
+				{ID: "port-multi-2", NetworkID: "subnet-2", DeviceID: "ecs-multi", DeviceOwner: "compute:cn-north-1a"},
+			}, "", nil)
+			// The ports are listed once for the
+			// instances and once for the associations
+			if tc.InlineEIPs {
+				ports.Times(2)
+			}
+
+			rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+			require.NoError(t, err)
+			require.Len(t, rs, 2)
+
+			assert.Equal(t, tc.EIPID, rs[0].Data().Get("eip_id"))
+			assert.Equal(t, "", rs[1].Data().Get("eip_id"))
+
+			rs, err = p.Resources(ctx, string(EIPAssociate), &filter.Filter{})
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(rs))
+			for _, r := range rs {
+				ids = append(ids, r.ID())
+			}
+			assert.Equal(t, tc.Associations, ids)
+		})
+	}
+}
+
+func TestInstancesFixedIPs(t *testing.T) {
+	network := func(subnet, ip string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"uuid":        cty.StringVal(subnet),
+			"fixed_ip_v4": cty.StringVal(ip),
+		})
+	}
+
+	tcs := []struct {
+		Name       string
+		PinDHCPIPs bool
+		FixedIPs   []cty.Value
+	}{
+		{
+			Name:     "Default",
+			FixedIPs: []cty.Value{cty.StringVal("192.168.1.10"), cty.NullVal(cty.String)},
+		},
+		{
+			Name:       "PinDHCPIPs",
+			PinDHCPIPs: true,
+			FixedIPs:   []cty.Value{cty.StringVal("192.168.1.10"), cty.StringVal("192.168.2.20")},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+				ctx  = context.Background()
+			)
+			defer ctrl.Finish()
+
+			p.options.PinDHCPIPs = tc.PinDHCPIPs
+
+			r.EXPECT().ListServers(ctx, reader.Page{}).Return([]reader.Server{{ID: "ecs-1"}}, "", nil)
+			r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return(nil, "", nil)
+			if !tc.PinDHCPIPs {
+				r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{
+					{ID: "subnet-static", VpcID: "vpc-1", CIDR: "192.168.1.0/24"},
+					{ID: "subnet-dhcp", VpcID: "vpc-1", CIDR: "192.168.2.0/24", DHCPEnable: true},
+				}, "", nil)
+			}
+
+			rs, err := p.Resources(ctx, string(ComputeInstance), &filter.Filter{})
+			require.NoError(t, err)
+			require.Len(t, rs, 1)
+
+			// The primary NIC is on a subnet without DHCP, so
+			// its IP was assigned manually and is always kept
+			v, err := p.FixResource(string(ComputeInstance), cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("ecs-1"),
+				"network": cty.ListVal([]cty.Value{
+					network("subnet-static", "192.168.1.10"),
+					network("subnet-dhcp", "192.168.2.20"),
+				}),
+			}))
+			require.NoError(t, err)
+
+			nets := v.GetAttr("network").AsValueSlice()
+			require.Len(t, nets, 2)
+			for i, n := range nets {
+				assert.Equal(t, tc.FixedIPs[i], n.GetAttr("fixed_ip_v4"))
+			}
+		})
+	}
+}
+
+func TestASLifecycleHooks(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	topicURN := "urn:smn:cn-north-1:123456:scaling"
+
+	r.EXPECT().ListASGroups(ctx, reader.Page{}).Return([]reader.ASGroup{
+		{ID: "group-1"},
+		{ID: "group-2"},
+	}, "", nil)
+	r.EXPECT().ListSMNTopics(ctx, reader.Page{}).Return([]reader.SMNTopic{{URN: topicURN, Name: "scaling"}}, "", nil)
+	r.EXPECT().ListASLifecycleHooks(ctx, "group-1").Return([]reader.ASLifecycleHook{
+		{Name: "add", Type: "ADD", NotificationTopicURN: topicURN},
+		{Name: "remove", Type: "REMOVE", NotificationTopicURN: "urn:smn:cn-north-1:123456:other"},
+	}, nil)
+	r.EXPECT().ListASLifecycleHooks(ctx, "group-2").Return([]reader.ASLifecycleHook{}, nil)
+
+	rs, err := p.Resources(ctx, string(ASLifecycleHook), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "group-1/add", rs[0].ID())
+	assert.Equal(t, "group-1", rs[0].Data().Get("scaling_group_id"))
+	assert.Equal(t, topicURN, rs[0].Data().Get("notification_topic_urn"))
+
+	assert.Equal(t, "group-1/remove", rs[1].ID())
+	assert.Equal(t, "group-1", rs[1].Data().Get("scaling_group_id"))
+	assert.Equal(t, "", rs[1].Data().Get("notification_topic_urn"))
+}
+
+func TestDBSSInstances(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "dbss"}}, "", nil)
+
+	r.EXPECT().ListDBSSInstances(ctx).Return([]reader.DBSSInstance{
+		{ID: "dbss-1", Status: "ACTIVE", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+		{ID: "dbss-building", Status: "BUILD", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+		{ID: "dbss-other-vpc", Status: "SHUTOFF", VPCID: "vpc-2", SubnetID: "subnet-2", SecurityGroupID: "sg-1"},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(DBSSInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "dbss-1", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[0].Data().Get("security_group_id"))
+
+	assert.Equal(t, "dbss-other-vpc", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, "", rs[1].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[1].Data().Get("security_group_id"))
+}
+
+func TestVPCSubnetsDHCPOptions(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{
+		{
+			ID: "subnet-custom", VpcID: "vpc-1", CIDR: "192.168.0.0/24", GatewayIP: "192.168.0.1", DHCPEnable: true,
+			PrimaryDNS: "10.0.0.53", SecondaryDNS: "10.0.0.54", DNSList: []string{"10.0.0.53", "10.0.0.54", "100.125.1.250"},
+			DHCPLeaseTime: "12h",
+		},
+		{
+			ID: "subnet-default", VpcID: "vpc-1", CIDR: "192.168.1.0/24", GatewayIP: "192.168.1.1", DHCPEnable: true,
+			PrimaryDNS: "100.125.1.250", SecondaryDNS: "100.125.64.250", DHCPLeaseTime: "24h",
+		},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(VPCSubnet), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	t.Run("Custom", func(t *testing.T) {
+		assert.Equal(t, "subnet-custom", rs[0].ID())
+		assert.Equal(t, "10.0.0.53", rs[0].Data().Get("primary_dns"))
+		assert.Equal(t, "10.0.0.54", rs[0].Data().Get("secondary_dns"))
+		assert.Equal(t, []interface{}{"10.0.0.53", "10.0.0.54", "100.125.1.250"}, rs[0].Data().Get("dns_list"))
+		assert.Equal(t, "12h", rs[0].Data().Get("dhcp_lease_time"))
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		assert.Equal(t, "subnet-default", rs[1].ID())
+		for _, k := range []string{"primary_dns", "secondary_dns", "dns_list", "dhcp_lease_time"} {
+			_, ok := rs[1].Data().GetOk(k)
+			assert.False(t, ok, k)
+		}
+	})
+}
+
+func TestVPCSubnetsIPv6(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{
+		{ID: "subnet-ipv4", VpcID: "vpc-1", CIDR: "192.168.0.0/24", GatewayIP: "192.168.0.1"},
+		{
+			ID: "subnet-dual", VpcID: "vpc-1", CIDR: "192.168.1.0/24", GatewayIP: "192.168.1.1",
+			IPv6Enable: true, IPv6CIDR: "2407:c080:802:be7::/64", IPv6Gateway: "2407:c080:802:be7::1", IPv6SubnetID: "ipv6-subnet-1",
+		},
+		{ID: "subnet-ipv6", VpcID: "vpc-1", IPv6Enable: true, IPv6CIDR: "2407:c080:802:be8::/64", IPv6Gateway: "2407:c080:802:be8::1"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(VPCSubnet), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	t.Run("IPv4Only", func(t *testing.T) {
+		assert.Equal(t, "subnet-ipv4", rs[0].ID())
+		assert.Equal(t, "192.168.0.0/24", rs[0].Data().Get("cidr"))
+		assert.Equal(t, "192.168.0.1", rs[0].Data().Get("gateway_ip"))
+		assert.Equal(t, false, rs[0].Data().Get("ipv6_enable"))
+		assert.Equal(t, "", rs[0].Data().Get("ipv6_cidr"))
+	})
+
+	t.Run("DualStack", func(t *testing.T) {
+		assert.Equal(t, "subnet-dual", rs[1].ID())
+		assert.Equal(t, "192.168.1.0/24", rs[1].Data().Get("cidr"))
+		assert.Equal(t, true, rs[1].Data().Get("ipv6_enable"))
+		assert.Equal(t, "2407:c080:802:be7::/64", rs[1].Data().Get("ipv6_cidr"))
+		assert.Equal(t, "2407:c080:802:be7::1", rs[1].Data().Get("ipv6_gateway"))
+		assert.Equal(t, "ipv6-subnet-1", rs[1].Data().Get("ipv6_subnet_id"))
+	})
+
+	t.Run("IPv6OnlySkipped", func(t *testing.T) {
+		ids, err := getVPCSubnetIDs(ctx, p, string(VPCSubnet), &filter.Filter{})
+		require.NoError(t, err)
+		assert.NotContains(t, ids, "subnet-ipv6")
+	})
+}
+
+func TestVPCsSecondaryCIDRs(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{
+		{ID: "vpc-none", CIDR: "192.168.0.0/16"},
+		{ID: "vpc-one", CIDR: "192.168.0.0/16", SecondaryCIDRs: []string{"10.0.0.0/16"}},
+		{ID: "vpc-multiple", CIDR: "192.168.0.0/16", SecondaryCIDRs: []string{"10.0.0.0/16", "10.1.0.0/16", "172.16.0.0/16"}},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(VPC), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	cidrs := func(r provider.Resource) []interface{} {
+		return r.Data().Get("secondary_cidrs").(*schema.Set).List()
+	}
+
+	t.Run("None", func(t *testing.T) {
+		assert.Equal(t, "vpc-none", rs[0].ID())
+		_, ok := rs[0].Data().GetOk("secondary_cidrs")
+		assert.False(t, ok)
+	})
+
+	t.Run("One", func(t *testing.T) {
+		assert.Equal(t, "vpc-one", rs[1].ID())
+		assert.ElementsMatch(t, []interface{}{"10.0.0.0/16"}, cidrs(rs[1]))
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		assert.Equal(t, "vpc-multiple", rs[2].ID())
+		assert.ElementsMatch(t, []interface{}{"10.0.0.0/16", "10.1.0.0/16", "172.16.0.0/16"}, cidrs(rs[2]))
+	})
+}
+
+func TestELBCertificates(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed only once as the second read is from the cache
+	r.EXPECT().ListELBCertificates(ctx, reader.Page{}).Return([]reader.ELBCertificate{
+		{ID: "cert-server", Name: "web", Type: "server"},
+		{ID: "cert-client", Name: "ca", Type: "client"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ELBCertificate), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "cert-server", rs[0].ID())
+	assert.Equal(t, "cert-client", rs[1].ID())
+	assert.Equal(t, "", rs[0].Data().Get("private_key"))
+
+	cached, err := cacheELBCertificates(ctx, p, string(ELBCertificate), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, cached, 2)
+}
+
+func TestELBIPGroups(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed only once as the reads of the listeners are from the cache
+	r.EXPECT().ListELBIPGroups(ctx, reader.Page{}).Return([]reader.ELBIPGroup{
+		{ID: "ipg-office", Name: "office", Listeners: []reader.ELBResourceRef{{ID: "listener-1"}, {ID: "listener-2"}}},
+		{ID: "ipg-unused", Name: "unused"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ELBIpGroup), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "ipg-office", rs[0].ID())
+	assert.Equal(t, []interface{}{"listener-1", "listener-2"}, rs[0].Data().Get("listener_ids"))
+	assert.Equal(t, 0, rs[1].Data().Get("listener_ids.#"))
+
+	ids, err := getELBListenerIPGroupIDs(ctx, p, string(ELBIpGroup), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"listener-1": "ipg-office", "listener-2": "ipg-office"}, ids)
+}
+
+func TestELBSecurityPolicies(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed only once as the second read is from the cache
+	r.EXPECT().ListELBSecurityPolicies(ctx, reader.Page{}).Return([]reader.ELBSecurityPolicy{
+		{ID: "sp-system", Name: "tls-1-2", Listeners: []reader.ELBResourceRef{{ID: "listener-2"}}},
+		{ID: "sp-custom", Name: "web-tls", Listeners: []reader.ELBResourceRef{{ID: "listener-1"}}},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ELBSecurityPolicy), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "sp-custom", rs[0].ID())
+
+	ids, err := getELBListenerSecurityPolicyIDs(ctx, p, string(ELBSecurityPolicy), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"listener-1": "sp-custom"}, ids)
+}
+
+func TestELBMonitors(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed only once as the second read is from the cache
+	r.EXPECT().ListELBPools(ctx, reader.Page{}).Return([]reader.ELBPool{
+		{ID: "pool-web", Name: "web", HealthMonitorID: "monitor-web"},
+		{ID: "pool-nocheck", Name: "nocheck"},
+	}, "2", nil)
+	r.EXPECT().ListELBPools(ctx, reader.Page{Marker: "2"}).Return([]reader.ELBPool{
+		{ID: "pool-api", Name: "api", HealthMonitorID: "monitor-api"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ELBMonitor), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "monitor-web", rs[0].ID())
+	assert.Equal(t, "pool-web", rs[0].Data().Get("pool_id"))
+	assert.Equal(t, "monitor-api", rs[1].ID())
+	assert.Equal(t, "pool-api", rs[1].Data().Get("pool_id"))
+
+	pools, err := cacheELBPools(ctx, p)
+	require.NoError(t, err)
+	assert.Len(t, pools, 3)
+}
+
+func TestELBL7Policies(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// The TCP listener has no forwarding policies so they are not listed
+	r.EXPECT().ListELBListeners(ctx, reader.Page{}).Return([]reader.ELBListener{
+		{ID: "listener-https", Name: "https", Protocol: "HTTPS"},
+		{ID: "listener-tcp", Name: "tcp", Protocol: "TCP"},
+		{ID: "listener-http", Name: "http", Protocol: "HTTP"},
+	}, "", nil)
+	r.EXPECT().ListELBL7Policies(ctx, "listener-https", reader.Page{}).Return([]reader.ELBL7Policy{
+		{ID: "policy-api", Name: "api", ListenerID: "listener-https", Action: "REDIRECT_TO_POOL", Rules: []reader.ELBResourceRef{{ID: "rule-api-path"}, {ID: "rule-api-host"}}},
+		{ID: "policy-static", Name: "static", ListenerID: "listener-https", Action: "REDIRECT_TO_POOL", Rules: []reader.ELBResourceRef{{ID: "rule-static-path"}}},
+	}, "", nil)
+	r.EXPECT().ListELBL7Policies(ctx, "listener-http", reader.Page{}).Return([]reader.ELBL7Policy{
+		{ID: "policy-redirect", Name: "redirect", ListenerID: "listener-http", Action: "REDIRECT_TO_LISTENER"},
+	}, "", nil)
+
+	// The policies are read once as the rules read them from the cache
+	r.EXPECT().ListELBL7Rules(ctx, "policy-api", reader.Page{}).Return([]reader.ELBL7Rule{
+		{ID: "rule-api-path", Type: "PATH", CompareType: "STARTS_WITH", Value: "/api"},
+	}, "2", nil)
+	r.EXPECT().ListELBL7Rules(ctx, "policy-api", reader.Page{Marker: "2"}).Return([]reader.ELBL7Rule{
+		{ID: "rule-api-host", Type: "HOST_NAME", CompareType: "EQUAL_TO", Value: "api.example.com"},
+	}, "", nil)
+	r.EXPECT().ListELBL7Rules(ctx, "policy-static", reader.Page{}).Return([]reader.ELBL7Rule{
+		{ID: "rule-static-path", Type: "PATH", CompareType: "STARTS_WITH", Value: "/static"},
+	}, "", nil)
+	r.EXPECT().ListELBL7Rules(ctx, "policy-redirect", reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(ELBL7Policy), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, e := range []struct{ ID, ListenerID string }{
+		{ID: "policy-api", ListenerID: "listener-https"},
+		{ID: "policy-static", ListenerID: "listener-https"},
+		{ID: "policy-redirect", ListenerID: "listener-http"},
+	} {
+		assert.Equal(t, e.ID, rs[i].ID())
+		assert.Equal(t, e.ListenerID, rs[i].Data().Get("listener_id"))
+	}
+
+	rs, err = p.Resources(ctx, string(ELBL7Rule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, e := range []struct{ ID, PolicyID string }{
+		{ID: "policy-api/rule-api-path", PolicyID: "policy-api"},
+		{ID: "policy-api/rule-api-host", PolicyID: "policy-api"},
+		{ID: "policy-static/rule-static-path", PolicyID: "policy-static"},
+	} {
+		assert.Equal(t, e.ID, rs[i].ID())
+		assert.Equal(t, e.PolicyID, rs[i].Data().Get("l7policy_id"))
+	}
+}
+
+func TestGESGraphs(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "ges"}}, "", nil)
+
+	r.EXPECT().ListGESGraphs(ctx, reader.Page{}).Return([]reader.GESGraph{
+		{ID: "ges-running", Status: "200", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+		{ID: "ges-creating", Status: "100", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+		{ID: "ges-failed", Status: "303", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+		{ID: "ges-stopped", Status: "900", VPCID: "vpc-2", SubnetID: "subnet-2", SecurityGroupID: "sg-2"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(GESGraph), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "ges-running", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[0].Data().Get("security_group_id"))
+
+	assert.Equal(t, "ges-stopped", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, "", rs[1].Data().Get("subnet_id"))
+	assert.Equal(t, "", rs[1].Data().Get("security_group_id"))
+}
+
+func TestCPHServers(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+
+	r.EXPECT().ListCPHServers(ctx, reader.Page{}).Return([]reader.CPHServer{
+		{ID: "cph-running", Status: 5, VPCID: "vpc-1", SubnetID: "subnet-1"},
+		{ID: "cph-creating", Status: 1, VPCID: "vpc-1", SubnetID: "subnet-1"},
+		{ID: "cph-failed", Status: 2, VPCID: "vpc-1", SubnetID: "subnet-1"},
+	}, "3", nil)
+	r.EXPECT().ListCPHServers(ctx, reader.Page{Marker: "3"}).Return([]reader.CPHServer{
+		{ID: "cph-stopped", Status: 10, VPCID: "vpc-2", SubnetID: "subnet-2"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(CPHServer), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "cph-running", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("subnet_id"))
+
+	assert.Equal(t, "cph-stopped", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, "", rs[1].Data().Get("subnet_id"))
+}
+
+func TestVPCEPEndpoints(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+
+	r.EXPECT().ListVPCEPEndpoints(ctx, reader.Page{}).Return([]reader.VPCEPEndpoint{
+		{ID: "vpcep-dns", Status: "accepted", VPCID: "vpc-1", EnableDNS: true, DNSNames: []string{"vpcep-dns.cn-north-4.myhuaweicloud.com", "other.cn-north-4.myhuaweicloud.com"}},
+		{ID: "vpcep-failed", Status: "failed", VPCID: "vpc-1", EnableDNS: true},
+	}, "2", nil)
+	r.EXPECT().ListVPCEPEndpoints(ctx, reader.Page{Marker: "2"}).Return([]reader.VPCEPEndpoint{
+		{ID: "vpcep-no-dns", Status: "pendingAcceptance", VPCID: "vpc-2"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(VPCEPEndpoint), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "vpcep-dns", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, true, rs[0].Data().Get("enable_dns"))
+	assert.Equal(t, "vpcep-dns.cn-north-4.myhuaweicloud.com", rs[0].Data().Get("private_domain_name"))
+
+	assert.Equal(t, "vpcep-no-dns", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	_, ok := rs[1].Data().GetOk("private_domain_name")
+	assert.False(t, ok)
+}
+
+func TestIMSImageShares(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListIMSPrivateImages(ctx, reader.Page{}).Return([]reader.IMSImage{
+		{ID: "img-shared", Status: "active"},
+		{ID: "img-private", Status: "active"},
+	}, "img-private", nil)
+	r.EXPECT().ListIMSPrivateImages(ctx, reader.Page{Marker: "img-private"}).Return([]reader.IMSImage{
+		{ID: "img-rejected", Status: "active"},
+	}, "", nil)
+
+	r.EXPECT().ListIMSImageMembers(ctx, "img-shared").Return([]reader.IMSImageMember{
+		{ProjectID: "project-3", Status: "pending"},
+		{ProjectID: "project-1", Status: "accepted"},
+		{ProjectID: "project-2", Status: "rejected"},
+		{ProjectID: "project-4", Status: "accepted"},
+	}, nil)
+	r.EXPECT().ListIMSImageMembers(ctx, "img-private").Return(nil, nil)
+	r.EXPECT().ListIMSImageMembers(ctx, "img-rejected").Return([]reader.IMSImageMember{
+		{ProjectID: "project-1", Status: "rejected"},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(IMSImageShare), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	projects := []interface{}{"project-1", "project-3", "project-4"}
+
+	assert.Equal(t, "img-shared", rs[0].ID())
+	assert.Equal(t, "img-shared", rs[0].Data().Get("source_image_id"))
+	assert.ElementsMatch(t, projects, rs[0].Data().Get("target_project_ids").(*schema.Set).List())
+
+	// The TF resource can not be imported, so the
+	// importer sets the projects of the share
+	d := rs[0].TFResource().Data(nil)
+	d.SetId("img-shared")
+	ds, err := rs[0].TFResource().Importer.StateContext(ctx, d, nil)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	assert.Equal(t, "img-shared", ds[0].Get("source_image_id"))
+	assert.ElementsMatch(t, projects, ds[0].Get("target_project_ids").(*schema.Set).List())
+
+	d = rs[0].TFResource().Data(nil)
+	d.SetId("img-private")
+	_, err = rs[0].TFResource().Importer.StateContext(ctx, d, nil)
+	assert.Error(t, err)
+}
+
+func TestSecMasterWorkspaces(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSecMasterWorkspaces(ctx, reader.Page{}).Return([]reader.SecMasterWorkspace{
+		{ID: "ws-1", Name: "security", RegionID: "cn-north-1", ProjectID: "123456"},
+		{ID: "ws-other-region", Name: "security", RegionID: "cn-south-1", ProjectID: "654321"},
+	}, "2", nil)
+	r.EXPECT().ListSecMasterWorkspaces(ctx, reader.Page{Marker: "2"}).Return([]reader.SecMasterWorkspace{
+		{ID: "ws-view", Name: "all-regions", RegionID: "cn-north-1", ProjectID: "123456", IsView: true},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(SecMasterWorkspace), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+	assert.Equal(t, "ws-1", rs[0].ID())
+	assert.Equal(t, string(SecMasterWorkspace), rs[0].Type())
+	assert.Equal(t, "ws-view", rs[1].ID())
+}
+
+func TestDMSRocketMQInstances(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "rocketmq"}}, "", nil)
+
+	r.EXPECT().ListDMSRocketMQInstances(ctx, reader.Page{}).Return([]reader.DMSRocketMQInstance{
+		{ID: "rocketmq-cluster", Status: "RUNNING", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1", BrokerNum: 3, NodeNum: 9},
+		{ID: "rocketmq-failed", Status: "CREATEFAILED", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1"},
+	}, "2", nil)
+	r.EXPECT().ListDMSRocketMQInstances(ctx, reader.Page{Marker: "2"}).Return([]reader.DMSRocketMQInstance{
+		{ID: "rocketmq-other-network", Status: "RUNNING", VPCID: "vpc-2", SubnetID: "subnet-2", SecurityGroupID: "sg-2", BrokerNum: 1},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(DMSRocketMQ), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "rocketmq-cluster", rs[0].ID())
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("subnet_id"))
+	assert.Equal(t, "sg-1", rs[0].Data().Get("security_group_id"))
+	assert.Equal(t, 3, rs[0].Data().Get("broker_num"))
+	assert.Equal(t, 9, rs[0].Data().Get("node_num"))
+
+	assert.Equal(t, "rocketmq-other-network", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("vpc_id"))
+	assert.Equal(t, 1, rs[1].Data().Get("broker_num"))
+}
+
+func TestDWSClusters(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "dws"}}, "", nil)
+
+	r.EXPECT().ListDWSClusters(ctx).Return([]reader.DWSCluster{
+		{ID: "dws-available", Status: "AVAILABLE", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1", NodeType: "dwsk2.xlarge", NumberOfNode: 3},
+		{ID: "dws-creating", Status: "CREATING", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1", NodeType: "dwsk2.xlarge", NumberOfNode: 3},
+		{ID: "dws-failed", Status: "CREATE_FAILED", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1", NodeType: "dwsk2.xlarge", NumberOfNode: 3},
+	}, nil)
+
+	rs, err := p.Resources(ctx, string(DWSCluster), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "dws-available", rs[0].ID())
+	assert.Equal(t, "dwsk2.xlarge", rs[0].Data().Get("node_type"))
+	assert.Equal(t, 3, rs[0].Data().Get("number_of_node"))
+	assert.Equal(t, "vpc-1", rs[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", rs[0].Data().Get("network_id"))
+	assert.Equal(t, "sg-1", rs[0].Data().Get("security_group_id"))
+}
+
+func TestModelArtsNotebooks(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListModelArtsNotebooks(ctx, reader.Page{}).Return([]reader.ModelArtsNotebook{
+		{ID: "notebook-running", Status: "RUNNING", Flavor: "modelarts.vm.cpu.2u"},
+		{ID: "notebook-deleting", Status: "DELETING", Flavor: "modelarts.vm.cpu.2u"},
+	}, "1", nil)
+	r.EXPECT().ListModelArtsNotebooks(ctx, reader.Page{Marker: "1"}).Return([]reader.ModelArtsNotebook{
+		{ID: "notebook-stopped", Status: "STOPPED", Flavor: "modelarts.vm.cpu.2u"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(ModelArtsNotebook), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "notebook-running", rs[0].ID())
+	assert.Equal(t, "notebook-stopped", rs[1].ID())
+}
+
+func TestRDSReadReplicas(t *testing.T) {
+	instances := []reader.RDSInstance{
+		{ID: "rds-primary", Type: "Ha"},
+		{ID: "rds-replica", Type: "Replica", RelatedInstances: []reader.RDSRelatedInstance{{ID: "rds-primary", Type: "replica_of"}}},
+		{ID: "rds-replica-other", Type: "Replica", RelatedInstances: []reader.RDSRelatedInstance{{ID: "rds-other", Type: "replica_of"}}},
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return(instances, "", nil)
+		r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+
+		// The replicas are never imported as instances
+		rs, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "rds-primary", rs[0].ID())
+
+		rs, err = p.Resources(ctx, string(RDSReadReplica), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+	})
+
+	t.Run("AsRDSReadReplicas", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RDSReadReplicas = true
+
+		// The instances are listed once for the primary
+		// instances, which are cached, and once for the replicas
+		r.EXPECT().ListRDSInstances(ctx, reader.Page{}).Return(instances, "", nil).Times(2)
+		r.EXPECT().ListRDSInstanceParameters(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+
+		rs, err := p.Resources(ctx, string(RDSInstance), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "rds-primary", rs[0].ID())
+
+		rs, err = p.Resources(ctx, string(RDSReadReplica), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "rds-replica", rs[0].ID())
+		assert.Equal(t, "rds-primary", rs[0].Data().Get("primary_instance_id"))
+
+		assert.Equal(t, "rds-replica-other", rs[1].ID())
+		assert.Equal(t, "", rs[1].Data().Get("primary_instance_id"))
+	})
+}
+
+func TestCFWFirewalls(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListCFWFirewalls(ctx, reader.Page{}).Return([]reader.CFWFirewall{
+		{ID: "fw-running", Status: 2, ProtectObjects: []reader.CFWProtectObject{
+			{ObjectID: "object-1", ObjectName: "fw-running", Type: reader.CFWProtectObjectTypeInternet},
+		}},
+		{ID: "fw-creating", Status: 0},
+		{ID: "fw-deleting", Status: 1},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(CFWInstance), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+
+	assert.Equal(t, "fw-running", rs[0].ID())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"object_id": "object-1", "object_name": "fw-running", "type": 0},
+	}, rs[0].Data().Get("protect_objects"))
+}
+
+func TestCFWProtectionRules(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListCFWFirewalls(ctx, reader.Page{}).Return([]reader.CFWFirewall{
+		{ID: "fw-1", Status: 2, ProtectObjects: []reader.CFWProtectObject{
+			{ObjectID: "object-1", Type: reader.CFWProtectObjectTypeInternet},
+			{ObjectID: "object-1-vpc", Type: 1},
+		}},
+		{ID: "fw-2", Status: 2, ProtectObjects: []reader.CFWProtectObject{
+			{ObjectID: "object-2", Type: reader.CFWProtectObjectTypeInternet},
+		}},
+		{ID: "fw-vpc", Status: 2, ProtectObjects: []reader.CFWProtectObject{
+			{ObjectID: "object-vpc", Type: 1},
+		}},
+	}, "", nil)
+	r.EXPECT().ListCFWProtectionRules(ctx, "object-1", reader.Page{}).Return([]reader.CFWProtectionRule{
+		{ID: "rule-1"},
+	}, "1", nil)
+	r.EXPECT().ListCFWProtectionRules(ctx, "object-1", reader.Page{Marker: "1"}).Return([]reader.CFWProtectionRule{
+		{ID: "rule-2"},
+	}, "", nil)
+	r.EXPECT().ListCFWProtectionRules(ctx, "object-2", reader.Page{}).Return([]reader.CFWProtectionRule{
+		{ID: "rule-3"},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(CFWRule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	assert.Equal(t, "object-1/rule-1", rs[0].ID())
+	assert.Equal(t, "object-1", rs[0].Data().Get("object_id"))
+	assert.Equal(t, "object-1/rule-2", rs[1].ID())
+	assert.Equal(t, "object-1", rs[1].Data().Get("object_id"))
+	assert.Equal(t, "object-2/rule-3", rs[2].ID())
+	assert.Equal(t, "object-2", rs[2].Data().Get("object_id"))
+
+	// The firewalls are on the cache so
+	// they are not listed again
+	fws, err := p.Resources(ctx, string(CFWInstance), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, fws, 3)
+}
+
+func TestEGEventSubscriptions(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListEGEventChannels(ctx, reader.Page{}).Return([]reader.EGEventChannel{
+		{ID: "channel-1", ProviderType: "CUSTOM"},
+		{ID: "channel-2", ProviderType: "CUSTOM"},
+	}, "", nil)
+	r.EXPECT().ListEGEventSubscriptions(ctx, "channel-1", reader.Page{}).Return([]reader.EGEventSubscription{
+		{ID: "subscription-1", ChannelID: "channel-1"},
+		{ID: "subscription-2", ChannelID: "channel-1"},
+	}, "2", nil)
+	r.EXPECT().ListEGEventSubscriptions(ctx, "channel-1", reader.Page{Marker: "2"}).Return([]reader.EGEventSubscription{
+		{ID: "subscription-3", ChannelID: "channel-1"},
+	}, "", nil)
+	r.EXPECT().ListEGEventSubscriptions(ctx, "channel-2", reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(EGEventSubscription), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, id := range []string{"subscription-1", "subscription-2", "subscription-3"} {
+		assert.Equal(t, id, rs[i].ID())
+		assert.Equal(t, "channel-1", rs[i].Data().Get("channel_id"))
+	}
+
+	// The channels are on the cache so
+	// they are not listed again
+	channels, err := p.Resources(ctx, string(EGEventChannel), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, channels, 2)
+}
+
+func TestDMSKafkaTopics(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1"}}, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return([]reader.SecurityGroup{{ID: "sg-1", Name: "kafka"}}, "", nil)
+
+	r.EXPECT().ListDMSKafkaInstances(ctx, reader.Page{}).Return([]reader.DMSKafkaInstance{
+		{ID: "kafka-1", Status: "RUNNING", VPCID: "vpc-1", SubnetID: "subnet-1", SecurityGroupID: "sg-1", BrokerNum: 3},
+		{ID: "kafka-deleting", Status: "DELETING"},
+		{ID: "kafka-2", Status: "RUNNING"},
+	}, "", nil)
+	r.EXPECT().ListDMSKafkaTopics(ctx, "kafka-1", reader.Page{}).Return([]reader.DMSKafkaTopic{
+		{Name: "__consumer_offsets", Partitions: 50, Replicas: 3},
+		{Name: "orders", Partitions: 3, Replicas: 3},
+	}, "2", nil)
+	r.EXPECT().ListDMSKafkaTopics(ctx, "kafka-1", reader.Page{Marker: "2"}).Return([]reader.DMSKafkaTopic{
+		{Name: "payments", Partitions: 6, Replicas: 3},
+	}, "", nil)
+	r.EXPECT().ListDMSKafkaTopics(ctx, "kafka-2", reader.Page{}).Return([]reader.DMSKafkaTopic{
+		{Name: "__transaction_state", Partitions: 50, Replicas: 3},
+		{Name: "events", Partitions: 1, Replicas: 1},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(DMSKafkaTopic), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, tc := range []struct {
+		id       string
+		instance string
+	}{
+		{id: "kafka-1/orders", instance: "kafka-1"},
+		{id: "kafka-1/payments", instance: "kafka-1"},
+		{id: "kafka-2/events", instance: "kafka-2"},
+	} {
+		assert.Equal(t, tc.id, rs[i].ID())
+		assert.Equal(t, tc.instance, rs[i].Data().Get("instance_id"))
+	}
+
+	// The instances are on the cache so
+	// they are not listed again
+	instances, err := p.Resources(ctx, string(DMSKafka), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+
+	assert.Equal(t, "kafka-1", instances[0].ID())
+	assert.Equal(t, "vpc-1", instances[0].Data().Get("vpc_id"))
+	assert.Equal(t, "subnet-1", instances[0].Data().Get("network_id"))
+	assert.Equal(t, "sg-1", instances[0].Data().Get("security_group_id"))
+	assert.Equal(t, 3, instances[0].Data().Get("broker_num"))
+}
+
+func TestDMSKafkaConsumerGroups(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListVPCs(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListSubnets(ctx, reader.Page{}).Return(nil, "", nil)
+	r.EXPECT().ListSecurityGroups(ctx, reader.Page{}).Return(nil, "", nil)
+
+	r.EXPECT().ListDMSKafkaInstances(ctx, reader.Page{}).Return([]reader.DMSKafkaInstance{
+		{ID: "kafka-1", Status: "RUNNING"},
+		{ID: "kafka-2", Status: "RUNNING"},
+	}, "", nil)
+	r.EXPECT().ListDMSKafkaConsumerGroups(ctx, "kafka-1", reader.Page{}).Return([]reader.DMSKafkaConsumerGroup{
+		{Name: "__dms_monitor", State: "STABLE"},
+		{Name: "billing", State: "STABLE"},
+	}, "2", nil)
+	r.EXPECT().ListDMSKafkaConsumerGroups(ctx, "kafka-1", reader.Page{Marker: "2"}).Return([]reader.DMSKafkaConsumerGroup{
+		{Name: "shipping", State: "EMPTY"},
+	}, "", nil)
+	r.EXPECT().ListDMSKafkaConsumerGroups(ctx, "kafka-2", reader.Page{}).Return(nil, "", nil)
+
+	rs, err := p.Resources(ctx, string(DMSKafkaConsumerGroup), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	for i, tc := range []struct {
+		id   string
+		name string
+	}{
+		{id: "kafka-1/billing", name: "billing"},
+		{id: "kafka-1/shipping", name: "shipping"},
+	} {
+		assert.Equal(t, tc.id, rs[i].ID())
+		assert.Equal(t, "kafka-1", rs[i].Data().Get("instance_id"))
+		assert.Equal(t, tc.name, rs[i].Data().Get("name"))
+	}
+}
+
+func TestAOMAlarmRules(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+
+		topicURN   = "urn:smn:cn-north-1:123456:alarms"
+		missingURN = "urn:smn:cn-north-1:123456:other-project"
+	)
+	defer ctrl.Finish()
+
+	r.EXPECT().ListSMNTopics(ctx, reader.Page{}).Return([]reader.SMNTopic{{URN: topicURN, Name: "alarms"}}, "", nil)
+
+	r.EXPECT().ListAOMAlarmRules(ctx, reader.Page{}).Return([]reader.AOMAlarmRule{
+		{ID: "1001", Name: "aom-default", Type: reader.AOMAlarmRuleTypeDefault, AlarmActions: []string{topicURN}},
+		{ID: "1002", Name: "cpu-usage", AlarmActions: []string{topicURN, missingURN}, OKActions: []string{topicURN}},
+	}, "1002", nil)
+	r.EXPECT().ListAOMAlarmRules(ctx, reader.Page{Marker: "1002"}).Return([]reader.AOMAlarmRule{
+		{ID: "1003", Name: "memory-usage", AlarmActions: []string{missingURN}},
+	}, "", nil)
+
+	rs, err := p.Resources(ctx, string(AOMAlarmRule), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "1002", rs[0].ID())
+	assert.Equal(t, []interface{}{topicURN}, rs[0].Data().Get("alarm_actions"))
+	assert.Equal(t, []interface{}{topicURN}, rs[0].Data().Get("ok_actions"))
+	assert.Equal(t, 0, rs[0].Data().Get("insufficient_data_actions.#"))
+
+	// The topics that are not imported are not referenced
+	assert.Equal(t, "1003", rs[1].ID())
+	assert.Equal(t, 0, rs[1].Data().Get("alarm_actions.#"))
+}
+
+func TestDLITables(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		r    = mock.NewHuaweicloudReader(ctrl)
+		p    = newTestProvider(t, r)
+		ctx  = context.Background()
+	)
+	defer ctrl.Finish()
+
+	// Listed only once as the second read is from the cache
+	r.EXPECT().ListDLIDatabases(ctx, reader.Page{}).Return([]reader.DLIDatabase{
+		{Name: reader.DLIDefaultDatabase},
+		{Name: "sales", TableNumber: 3},
+		{Name: "logs"},
+	}, "", nil)
+
+	// The tables of the default database are not
+	// listed as it's not imported
+	r.EXPECT().ListDLITables(ctx, "sales", reader.Page{}).Return([]reader.DLITable{
+		{Name: "orders", TableType: "MANAGED"},
+		{Name: "customers", TableType: "MANAGED"},
+	}, "2", nil)
+	r.EXPECT().ListDLITables(ctx, "sales", reader.Page{Marker: "2"}).Return([]reader.DLITable{
+		{Name: "orders_view", TableType: "VIEW"},
+	}, "", nil)
+	r.EXPECT().ListDLITables(ctx, "logs", reader.Page{}).Return([]reader.DLITable{}, "", nil)
+
+	dbs, err := p.Resources(ctx, string(DLIDatabase), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, dbs, 2)
+	assert.Equal(t, "sales", dbs[0].ID())
+	assert.Equal(t, "sales", dbs[0].Data().Get("name"))
+	assert.Equal(t, "logs", dbs[1].ID())
+
+	rs, err := p.Resources(ctx, string(DLITable), &filter.Filter{})
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(rs))
+	for _, r := range rs {
+		ids = append(ids, r.ID())
+	}
+	assert.Equal(t, []string{"sales/orders", "sales/customers", "sales/orders_view"}, ids)
+	assert.Equal(t, "sales", rs[0].Data().Get("database_name"))
+	assert.Equal(t, "orders", rs[0].Data().Get("name"))
+}
+
+func TestIoTDADevices(t *testing.T) {
+	products := []reader.IoTDAProduct{
+		{ID: "product-1", Name: "sensor"},
+		{ID: "product-2", Name: "gateway"},
+	}
+
+	t.Run("PerProduct", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ListIoTDAProducts(ctx, reader.Page{}).Return(products, "", nil)
+		r.EXPECT().ListIoTDADevices(ctx, "product-1", reader.Page{}).Return([]reader.IoTDADevice{
+			{ID: "device-1", ProductID: "product-1"},
+			{ID: "device-2", ProductID: "product-1"},
+		}, "marker-2", nil)
+		r.EXPECT().ListIoTDADevices(ctx, "product-1", reader.Page{Marker: "marker-2"}).Return([]reader.IoTDADevice{
+			{ID: "device-3", ProductID: "product-1"},
+		}, "", nil)
+		r.EXPECT().ListIoTDADevices(ctx, "product-2", reader.Page{}).Return([]reader.IoTDADevice{
+			{ID: "device-4", ProductID: "product-2"},
+		}, "", nil)
+
+		rs, err := p.Resources(ctx, string(IoTDADevice), &filter.Filter{})
+		require.NoError(t, err)
+
+		ids := make([]string, 0, len(rs))
+		for _, r := range rs {
+			ids = append(ids, r.ID())
+		}
+		assert.Equal(t, []string{"device-1", "device-2", "device-3", "device-4"}, ids)
+		assert.Equal(t, "product-1", rs[0].Data().Get("product_id"))
+		assert.Equal(t, "product-2", rs[3].Data().Get("product_id"))
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		// Only the devices left to reach the max are listed,
+		// the second product is not read once it's reached
+		r.EXPECT().ListIoTDAProducts(ctx, reader.Page{}).Return(products, "", nil)
+		r.EXPECT().ListIoTDADevices(ctx, "product-1", reader.Page{Limit: 3}).Return([]reader.IoTDADevice{
+			{ID: "device-1", ProductID: "product-1"},
+			{ID: "device-2", ProductID: "product-1"},
+		}, "marker-2", nil)
+		r.EXPECT().ListIoTDADevices(ctx, "product-1", reader.Page{Limit: 1, Marker: "marker-2"}).Return([]reader.IoTDADevice{
+			{ID: "device-3", ProductID: "product-1"},
+		}, "marker-3", nil)
+
+		rs, err := p.Resources(ctx, string(IoTDADevice), &filter.Filter{MaxPerType: 3})
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+		assert.Equal(t, "device-3", rs[2].ID())
+	})
+}
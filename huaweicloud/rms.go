@@ -0,0 +1,301 @@
+package huaweicloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+)
+
+// rmsResourceTypes are the resource types built only from the RMS
+// inventory, indexed by the RMS 'provider.type' of the resources.
+// The other types need more than what the RMS returns (ex: the EVS
+// volumes skip the system disks and set their attachments, and the
+// RDS instances validate their flavor and read their configuration)
+// so they are always read from their service API
+var rmsResourceTypes = map[string]ResourceType{
+	"vpc.vpcs":           VPC,
+	"vpc.securityGroups": SecurityGroup,
+	"nat.natGateways":    NatGateway,
+	"as.scalingGroups":   ASGroup,
+}
+
+// skipRMSResource checks if the resource id, named name on the RMS,
+// of the type rt is skipped as its service reader does (ex: the
+// default security group)
+func skipRMSResource(p *huaweicloudProvider, rt ResourceType, id, name string) bool {
+	switch rt {
+	case SecurityGroup:
+		return skipDefault(p, string(rt), id, name == defaultSecurityGroupName)
+	}
+	return false
+}
+
+// isMaxPerTypeReached checks if all the types of res
+// have reached the f.MaxPerType, so no more is read
+func isMaxPerTypeReached(f *filter.Filter, res map[string][]provider.Resource) bool {
+	for _, rs := range res {
+		if !f.IsMaxPerTypeReached(len(rs)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRMSResourceType checks if the resource type
+// rt can be read from the RMS inventory
+func isRMSResourceType(rt ResourceType) bool {
+	for _, t := range rmsResourceTypes {
+		if t == rt {
+			return true
+		}
+	}
+	return false
+}
+
+// rmsResources returns the resources of the types, supported by the
+// rmsResourceTypes, listed with a single read of the RMS inventory and
+// grouped by type. The types read are also cached so the other types
+// can reference them. If the RMS is not available it returns false
+// and the types have to be read from their service API
+func rmsResources(ctx context.Context, p *huaweicloudProvider, types []string, f *filter.Filter) (map[string][]provider.Resource, bool, error) {
+	res := make(map[string][]provider.Resource)
+	for _, t := range types {
+		if isRMSResourceType(ResourceType(t)) {
+			res[t] = make([]provider.Resource, 0)
+		}
+	}
+
+	if len(res) == 0 {
+		return res, true, nil
+	}
+
+	setEPS := p.options.EnterpriseProjectID != "" || p.options.AllEnterpriseProjects
+
+	page := reader.Page{EnterpriseProjectID: p.options.EnterpriseProjectID}
+	for {
+		rrs, next, err := p.reader.ListRMSResources(ctx, page)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, false, ctx.Err()
+			}
+			kitlog.With(p.baseLogger(), "region", p.Region()).Log("func", "huaweicloud.rmsResources", "msg", fmt.Sprintf("the RMS is not available, the resources are read from the services: %s", err.Error()))
+			return nil, false, nil
+		}
+
+		for _, rr := range rrs {
+			rt, ok := rmsResourceTypes[rr.Provider+"."+rr.Type]
+			if !ok {
+				continue
+			}
+
+			t := string(rt)
+			rs, ok := res[t]
+			if !ok || f.IsMaxPerTypeReached(len(rs)) || skipRMSResource(p, rt, rr.ID, rr.Name) {
+				continue
+			}
+
+			r := provider.NewResource(rr.ID, t, p)
+			if setEPS {
+				if err := setEnterpriseProjectID(r, rr.EnterpriseProjectID); err != nil {
+					return nil, false, err
+				}
+			}
+
+			res[t] = append(rs, r)
+		}
+
+		if next == "" || isMaxPerTypeReached(f, res) {
+			break
+		}
+		page.Marker = next
+	}
+
+	for t, rs := range res {
+		if err := p.cache.Set(t, rs); err != nil {
+			return nil, false, err
+		}
+		if tf, ok := p.options.TypeFilters[t]; ok {
+			res[t] = tf.truncate(rs)
+		}
+		readerLogger(p, t).Log("func", "huaweicloud.rmsResources", "msg", "resources read from the RMS", "count", len(rs))
+	}
+
+	return res, true, nil
+}
+
+// resourceQLMaxLength is the maximum length of
+// the ResourceQL queries accepted by the RMS
+const resourceQLMaxLength = 4096
+
+// resourceQLFields are the fields the ResourceQL queries have to
+// select to build the resources, as on the RMS 'resources' table.
+// The 'region_id' is needed as the queries are of all the regions
+// and the 'ep_id' to set the enterprise project of the resources
+var resourceQLFields = []string{"id", "provider", "type", "region_id", "ep_id"}
+
+// validateResourceQL checks that q is a single ResourceQL SELECT query
+// that the RMS accepts, the fields selected are checked on its result
+func validateResourceQL(q string) error {
+	q = strings.TrimSuffix(strings.TrimSpace(q), ";")
+	if q == "" {
+		return errors.New("invalid ResourceQL, it can not be empty")
+	}
+	if len(q) > resourceQLMaxLength {
+		return errors.Errorf("invalid ResourceQL, it has %d characters and the maximum is %d", len(q), resourceQLMaxLength)
+	}
+	if f := strings.Fields(q); !strings.EqualFold(f[0], "SELECT") {
+		return errors.Errorf("invalid ResourceQL %q, it has to be a SELECT", q)
+	}
+	if strings.Contains(q, ";") {
+		return errors.Errorf("invalid ResourceQL %q, it has to be a single query", q)
+	}
+
+	return nil
+}
+
+// resourceQLResources returns the resources of the types selected by the
+// Options.ResourceQL, grouped by type, instead of reading the types from
+// their service API. The rows of the types that are not on the
+// rmsResourceTypes or of other regions are skipped and logged, and so
+// are the ones of the types that are not read. The types read are also
+// cached so the other types can reference them. It fails if the query
+// does not select the resourceQLFields or if the RMS is not available
+func resourceQLResources(ctx context.Context, p *huaweicloudProvider, types []string, f *filter.Filter) (map[string][]provider.Resource, error) {
+	res := make(map[string][]provider.Resource)
+	for _, t := range types {
+		if isRMSResourceType(ResourceType(t)) {
+			res[t] = make([]provider.Resource, 0)
+		}
+	}
+
+	qr, err := p.reader.RunRMSQuery(ctx, p.options.ResourceQL)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to run the ResourceQL")
+	}
+
+	if err := validateResourceQLFields(qr); err != nil {
+		return nil, err
+	}
+
+	setEPS := p.options.EnterpriseProjectID != "" || p.options.AllEnterpriseProjects
+
+	// The number of rows of each RMS type that is not supported
+	// and of each other region, to log them once the rows are read
+	var (
+		unsupported = make(map[string]int)
+		regions     = make(map[string]int)
+	)
+
+	for _, row := range qr.Results {
+		id, _ := row["id"].(string)
+		rrProvider, _ := row["provider"].(string)
+		rrType, _ := row["type"].(string)
+		rrRegion, _ := row["region_id"].(string)
+		if id == "" {
+			continue
+		}
+
+		// The rows without region are global, the
+		// ones of other regions can not be read
+		if rrRegion != "" && rrRegion != p.Region() {
+			regions[rrRegion]++
+			continue
+		}
+
+		rt, ok := rmsResourceTypes[rrProvider+"."+rrType]
+		if !ok {
+			unsupported[rrProvider+"."+rrType]++
+			continue
+		}
+
+		t := string(rt)
+		rs, ok := res[t]
+		name, _ := row["name"].(string)
+		if !ok || f.IsMaxPerTypeReached(len(rs)) || skipRMSResource(p, rt, id, name) {
+			continue
+		}
+
+		r := provider.NewResource(id, t, p)
+		if setEPS {
+			epsID, _ := row["ep_id"].(string)
+			if err := setEnterpriseProjectID(r, epsID); err != nil {
+				return nil, err
+			}
+		}
+
+		res[t] = append(rs, r)
+	}
+
+	rmsTypes := make([]string, 0, len(unsupported))
+	for rmsType := range unsupported {
+		rmsTypes = append(rmsTypes, rmsType)
+	}
+	sort.Strings(rmsTypes)
+	for _, rmsType := range rmsTypes {
+		kitlog.With(p.baseLogger(), "region", p.Region()).Log("func", "huaweicloud.resourceQLResources", "msg", fmt.Sprintf("the RMS type %q of the ResourceQL is not supported, its resources are not imported", rmsType), "count", unsupported[rmsType])
+	}
+
+	otherRegions := make([]string, 0, len(regions))
+	for region := range regions {
+		otherRegions = append(otherRegions, region)
+	}
+	sort.Strings(otherRegions)
+	for _, region := range otherRegions {
+		kitlog.With(p.baseLogger(), "region", p.Region()).Log("func", "huaweicloud.resourceQLResources", "msg", fmt.Sprintf("the resources of the region %q of the ResourceQL are not imported", region), "count", regions[region])
+	}
+
+	for t, rs := range res {
+		if err := p.cache.Set(t, rs); err != nil {
+			return nil, err
+		}
+		if tf, ok := p.options.TypeFilters[t]; ok {
+			res[t] = tf.truncate(rs)
+		}
+		readerLogger(p, t).Log("func", "huaweicloud.resourceQLResources", "msg", "resources read from the ResourceQL", "count", len(rs))
+	}
+
+	return res, nil
+}
+
+// validateResourceQLFields checks that the ResourceQL result qr has the
+// resourceQLFields, which are its SelectFields or, if the RMS does not
+// return them, the fields of each one of its Results
+func validateResourceQLFields(qr reader.RMSQueryResult) error {
+	check := func(fields []string) error {
+		selected := make(map[string]struct{}, len(fields))
+		for _, sf := range fields {
+			selected[sf] = struct{}{}
+		}
+		for _, rf := range resourceQLFields {
+			if _, ok := selected[rf]; !ok {
+				return errors.Errorf("invalid ResourceQL, it has to select the %s of the resources and it selects %s", strings.Join(resourceQLFields, ", "), strings.Join(fields, ", "))
+			}
+		}
+		return nil
+	}
+
+	if len(qr.SelectFields) != 0 {
+		return check(qr.SelectFields)
+	}
+
+	for _, row := range qr.Results {
+		fields := make([]string, 0, len(row))
+		for f := range row {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+
+		if err := check(fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,385 @@
+package huaweicloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcesBatchRMS(t *testing.T) {
+	types := []string{string(VPC), string(EVSVolume), string(VPCSubnet)}
+
+	t.Run("Available", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return([]reader.RMSResource{
+			{ID: "vpc-1", Provider: "vpc", Type: "vpcs"},
+			{ID: "ecs-1", Provider: "ecs", Type: "cloudservers"},
+		}, "next", nil)
+		r.EXPECT().ListRMSResources(ctx, reader.Page{Marker: "next"}).Return([]reader.RMSResource{
+			{ID: "vol-1", Provider: "evs", Type: "volumes"},
+		}, "", nil)
+
+		// The VPCs are not listed from the VPC API as they were
+		// cached from the RMS, the EVS volumes always are
+		r.EXPECT().ListSubnets(gomock.Any(), reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1", CIDR: "10.0.0.0/24"}}, "", nil)
+		r.EXPECT().ListEVSVolumes(gomock.Any(), reader.Page{}).Return([]reader.EVSVolume{{ID: "vol-1"}}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, types, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, len(types))
+
+		require.Len(t, res[string(VPC)], 1)
+		assert.Equal(t, "vpc-1", res[string(VPC)][0].ID())
+		require.Len(t, res[string(EVSVolume)], 1)
+		assert.Equal(t, "vol-1", res[string(EVSVolume)][0].ID())
+		require.Len(t, res[string(VPCSubnet)], 1)
+		assert.Equal(t, "vpc-1", res[string(VPCSubnet)][0].Data().Get("vpc_id"))
+	})
+
+	t.Run("EVSVolumes", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+
+		// The RMS is not read as it has not the system disks nor the
+		// attachments of the volumes, so they are read from the EVS API
+		r.EXPECT().ListEVSVolumes(gomock.Any(), reader.Page{}).Return([]reader.EVSVolume{
+			{ID: "vol-system", Bootable: "true", Attachments: []reader.EVSVolumeAttachment{{ServerID: "ecs-1", Device: "/dev/vda"}}},
+			{ID: "vol-shared", Bootable: "false", Multiattach: true, Attachments: []reader.EVSVolumeAttachment{
+				{ServerID: "ecs-1", Device: "/dev/vdb"},
+				{ServerID: "ecs-2", Device: "/dev/vdb"},
+			}},
+		}, "", nil).Times(2)
+		r.EXPECT().ListServers(gomock.Any(), reader.Page{}).Return([]reader.Server{{ID: "ecs-1"}, {ID: "ecs-2"}}, "", nil)
+		r.EXPECT().ListSubnets(gomock.Any(), reader.Page{}).Return(nil, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(EVSVolume), string(ComputeVolumeAttach)}, &filter.Filter{})
+		require.NoError(t, err)
+
+		require.Len(t, res[string(EVSVolume)], 1)
+		assert.Equal(t, "vol-shared", res[string(EVSVolume)][0].ID())
+		assert.Equal(t, true, res[string(EVSVolume)][0].Data().Get("multiattach"))
+
+		require.Len(t, res[string(ComputeVolumeAttach)], 2)
+		assert.Equal(t, "ecs-1/vol-shared", res[string(ComputeVolumeAttach)][0].ID())
+		assert.Equal(t, "ecs-2/vol-shared", res[string(ComputeVolumeAttach)][1].ID())
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return(nil, "", errors.New("RMS is not enabled"))
+		r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+		r.EXPECT().ListEVSVolumes(gomock.Any(), reader.Page{}).Return([]reader.EVSVolume{}, "", nil)
+		r.EXPECT().ListSubnets(gomock.Any(), reader.Page{}).Return([]reader.Subnet{{ID: "subnet-1", VpcID: "vpc-1", CIDR: "10.0.0.0/24"}}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, types, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, len(types))
+
+		require.Len(t, res[string(VPC)], 1)
+		assert.Equal(t, "vpc-1", res[string(VPC)][0].ID())
+		assert.Len(t, res[string(EVSVolume)], 0)
+		require.Len(t, res[string(VPCSubnet)], 1)
+	})
+
+	t.Run("EnterpriseProject", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+		p.options.EnterpriseProjectID = "eps-1"
+
+		r.EXPECT().ListRMSResources(ctx, reader.Page{EnterpriseProjectID: "eps-1"}).Return([]reader.RMSResource{
+			{ID: "vpc-1", Provider: "vpc", Type: "vpcs", EnterpriseProjectID: "eps-1"},
+		}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC)}, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res[string(VPC)], 1)
+		assert.Equal(t, "eps-1", res[string(VPC)][0].Data().Get("enterprise_project_id"))
+	})
+
+	t.Run("SecurityGroupsAndASGroups", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+
+		// The default security group is skipped as with the VPC API
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return([]reader.RMSResource{
+			{ID: "sg-default", Name: defaultSecurityGroupName, Provider: "vpc", Type: "securityGroups"},
+			{ID: "sg-1", Name: "web", Provider: "vpc", Type: "securityGroups"},
+			{ID: "group-1", Name: "web", Provider: "as", Type: "scalingGroups"},
+		}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(SecurityGroup), string(ASGroup)}, &filter.Filter{})
+		require.NoError(t, err)
+
+		require.Len(t, res[string(SecurityGroup)], 1)
+		assert.Equal(t, "sg-1", res[string(SecurityGroup)][0].ID())
+		require.Len(t, res[string(ASGroup)], 1)
+		assert.Equal(t, "group-1", res[string(ASGroup)][0].ID())
+	})
+
+	t.Run("MaxPerType", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+
+		// The next page is not read as all the types reached the cap
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return([]reader.RMSResource{
+			{ID: "vpc-1", Provider: "vpc", Type: "vpcs"},
+			{ID: "group-1", Provider: "as", Type: "scalingGroups"},
+			{ID: "vpc-2", Provider: "vpc", Type: "vpcs"},
+		}, "next", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC), string(ASGroup)}, &filter.Filter{MaxPerType: 1})
+		require.NoError(t, err)
+
+		require.Len(t, res[string(VPC)], 1)
+		assert.Equal(t, "vpc-1", res[string(VPC)][0].ID())
+		require.Len(t, res[string(ASGroup)], 1)
+	})
+	t.Run("ChargingMode", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.RMS = true
+		p.options.ChargingMode = ChargingModePrePaid
+
+		// The EVS volumes are read from the EVS API
+		// which has their charging mode
+		r.EXPECT().ListRMSResources(ctx, reader.Page{}).Return([]reader.RMSResource{
+			{ID: "vpc-1", Provider: "vpc", Type: "vpcs"},
+			{ID: "vol-1", Provider: "evs", Type: "volumes"},
+		}, "", nil)
+		r.EXPECT().ListEVSVolumes(gomock.Any(), reader.Page{}).Return([]reader.EVSVolume{
+			{ID: "vol-1"},
+			{ID: "vol-2", Metadata: map[string]string{"orderID": "order-1"}},
+		}, "", nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC), string(EVSVolume)}, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res[string(VPC)], 1)
+		require.Len(t, res[string(EVSVolume)], 1)
+		assert.Equal(t, "vol-2", res[string(EVSVolume)][0].ID())
+	})
+}
+
+func TestResourcesBatchResourceQL(t *testing.T) {
+	const query = "SELECT id, provider, type, region_id, ep_id FROM resources WHERE provider IN ('vpc', 'evs', 'ecs')"
+
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.ResourceQL = query
+
+		// No service API is called, not even for the types
+		// not supported by the RMS like the subnets, and the
+		// rows of the other regions are skipped
+		r.EXPECT().RunRMSQuery(ctx, query).Return(reader.RMSQueryResult{
+			SelectFields: []string{"id", "provider", "type", "region_id", "ep_id"},
+			Results: []map[string]interface{}{
+				{"id": "vpc-1", "provider": "vpc", "type": "vpcs", "region_id": "cn-north-1", "ep_id": "0"},
+				{"id": "ecs-1", "provider": "ecs", "type": "cloudservers", "region_id": "cn-north-1", "ep_id": "0"},
+				{"id": "vol-1", "provider": "evs", "type": "volumes", "region_id": "cn-north-1", "ep_id": "0"},
+				{"id": "vpc-3", "provider": "vpc", "type": "vpcs", "region_id": "ap-southeast-1", "ep_id": "0"},
+				{"id": "vpc-2", "provider": "vpc", "type": "vpcs", "region_id": "cn-north-1", "ep_id": "0"},
+				{"id": "nat-1", "provider": "nat", "type": "natGateways", "region_id": "cn-north-1", "ep_id": "0"},
+			},
+		}, nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC), string(EVSVolume), string(VPCSubnet)}, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+
+		require.Len(t, res[string(VPC)], 2)
+		assert.Equal(t, "vpc-1", res[string(VPC)][0].ID())
+		assert.Equal(t, "vpc-2", res[string(VPC)][1].ID())
+	})
+
+	t.Run("EnterpriseProjectAndCache", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.ResourceQL = query
+		p.options.AllEnterpriseProjects = true
+
+		r.EXPECT().RunRMSQuery(ctx, query).Return(reader.RMSQueryResult{
+			SelectFields: []string{"id", "provider", "type", "region_id", "ep_id"},
+			Results: []map[string]interface{}{
+				{"id": "vpc-1", "provider": "vpc", "type": "vpcs", "region_id": "cn-north-1", "ep_id": "eps-1"},
+			},
+		}, nil)
+
+		res, err := p.ResourcesBatch(ctx, []string{string(VPC)}, &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, res[string(VPC)], 1)
+		assert.Equal(t, "eps-1", res[string(VPC)][0].Data().Get("enterprise_project_id"))
+
+		// The VPCs are cached, so they are not listed from the VPC API
+		rs, err := cacheVPCs(ctx, p, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Equal(t, res[string(VPC)], rs)
+	})
+
+	t.Run("ErrorSelectFields", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.ResourceQL = "SELECT id, name FROM resources"
+
+		r.EXPECT().RunRMSQuery(ctx, "SELECT id, name FROM resources").Return(reader.RMSQueryResult{
+			SelectFields: []string{"id", "name"},
+			Results: []map[string]interface{}{
+				{"id": "vpc-1", "name": "vpc"},
+			},
+		}, nil)
+
+		_, err := p.ResourcesBatch(ctx, []string{string(VPC)}, &filter.Filter{})
+		require.Error(t, err)
+		assert.Equal(t, "invalid ResourceQL, it has to select the id, provider, type, region_id, ep_id of the resources and it selects id, name", err.Error())
+	})
+
+	t.Run("ErrorResultFields", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.ResourceQL = "SELECT id, provider, type FROM resources"
+
+		// Without the select fields the ones of the rows are checked
+		r.EXPECT().RunRMSQuery(ctx, "SELECT id, provider, type FROM resources").Return(reader.RMSQueryResult{
+			Results: []map[string]interface{}{
+				{"id": "vpc-1", "provider": "vpc", "type": "vpcs"},
+			},
+		}, nil)
+
+		_, err := p.ResourcesBatch(ctx, []string{string(VPC)}, &filter.Filter{})
+		require.Error(t, err)
+		assert.Equal(t, "invalid ResourceQL, it has to select the id, provider, type, region_id, ep_id of the resources and it selects id, provider, type", err.Error())
+	})
+
+	t.Run("ErrorRMS", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.ResourceQL = query
+
+		r.EXPECT().RunRMSQuery(ctx, query).Return(reader.RMSQueryResult{}, errors.New("RMS is not enabled"))
+
+		_, err := p.ResourcesBatch(ctx, []string{string(VPC)}, &filter.Filter{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to run the ResourceQL")
+	})
+}
+
+func TestValidateResourceQL(t *testing.T) {
+	tcs := []struct {
+		Name  string
+		Query string
+		Err   string
+	}{
+		{Name: "Select", Query: "SELECT id, provider, type FROM resources"},
+		{Name: "LowerCaseWithSemicolon", Query: " select id, provider, type from resources; "},
+		{Name: "Empty", Query: " ; ", Err: "invalid ResourceQL, it can not be empty"},
+		{Name: "NotSelect", Query: "DELETE FROM resources", Err: `invalid ResourceQL "DELETE FROM resources", it has to be a SELECT`},
+		{Name: "MultipleQueries", Query: "SELECT id FROM resources; SELECT id FROM resources", Err: `invalid ResourceQL "SELECT id FROM resources; SELECT id FROM resources", it has to be a single query`},
+		{Name: "TooLong", Query: "SELECT " + strings.Repeat("a", resourceQLMaxLength), Err: "invalid ResourceQL, it has 4103 characters and the maximum is 4096"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateResourceQL(tc.Query)
+			if tc.Err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tc.Err, err.Error())
+		})
+	}
+
+	t.Run("Options", func(t *testing.T) {
+		assert.NoError(t, Options{ResourceQL: "SELECT id, provider, type, region_id, ep_id FROM resources"}.validate())
+		assert.Error(t, Options{ResourceQL: "DELETE FROM resources"}.validate())
+	})
+}
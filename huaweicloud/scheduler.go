@@ -0,0 +1,123 @@
+package huaweicloud
+
+import (
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// dehTenancy is the 'tenancy' of the 'scheduler_hints'
+// of the ECS instances placed on a Dedicated Host
+const dehTenancy = "dedicated"
+
+// serverSchedulerHints are the placement of an ECS instance: its server
+// (affinity) group, its fault domain, its tenancy and its Dedicated Host
+type serverSchedulerHints struct {
+	Group       string
+	FaultDomain string
+	Tenancy     string
+	DeHID       string
+}
+
+// newServerSchedulerHints returns the scheduler hints of the ECS
+// instance s, the ones placed on a Dedicated Host without tenancy
+// have the dehTenancy as the API does not always return it
+func newServerSchedulerHints(s reader.Server) serverSchedulerHints {
+	group, faultDomain, tenancy, deh := s.SchedulerHints.First()
+	if deh != "" && tenancy == "" {
+		tenancy = dehTenancy
+	}
+
+	return serverSchedulerHints{
+		Group:       group,
+		FaultDomain: faultDomain,
+		Tenancy:     tenancy,
+		DeHID:       deh,
+	}
+}
+
+// attributes returns the 'scheduler_hints' attributes of h
+// which are not empty, by name
+func (h serverSchedulerHints) attributes() map[string]string {
+	attrs := make(map[string]string, 4)
+	for k, v := range map[string]string{
+		"group":        h.Group,
+		"fault_domain": h.FaultDomain,
+		"tenancy":      h.Tenancy,
+		"deh_id":       h.DeHID,
+	} {
+		if v != "" {
+			attrs[k] = v
+		}
+	}
+
+	return attrs
+}
+
+// setServerSchedulerHints sets the h as the
+// 'scheduler_hints' of the ECS instance r
+func setServerSchedulerHints(r provider.Resource, h serverSchedulerHints) error {
+	hint := make(map[string]interface{})
+	for k, v := range h.attributes() {
+		hint[k] = v
+	}
+
+	if err := r.Data().Set("scheduler_hints", []interface{}{hint}); err != nil {
+		return errors.Wrapf(err, "unable to set scheduler_hints data on the provider.Resource for the ECS instance %q", r.ID())
+	}
+
+	return nil
+}
+
+// fixComputeInstanceSchedulerHints sets the scheduler hints of the instance
+// v on its 'scheduler_hints', as the TF provider only reads the 'group' of
+// them so the 'fault_domain', the 'tenancy' and the 'deh_id' are lost and a
+// 'terraform apply' would recreate the instance without them. The instances
+// without scheduler hints are not changed
+func fixComputeInstanceSchedulerHints(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") || !v.Type().HasAttribute("scheduler_hints") {
+		return v
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v
+	}
+
+	p.instanceSchedulerHintsMu.Lock()
+	h, ok := p.instanceSchedulerHints[id.AsString()]
+	p.instanceSchedulerHintsMu.Unlock()
+	if !ok {
+		return v
+	}
+
+	sh := v.GetAttr("scheduler_hints")
+	if !sh.IsKnown() {
+		return v
+	}
+
+	ety := sh.Type().ElementType()
+	attrs := make(map[string]cty.Value, len(ety.AttributeTypes()))
+	for k, t := range ety.AttributeTypes() {
+		attrs[k] = cty.NullVal(t)
+	}
+	if !sh.IsNull() {
+		for it := sh.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			for k, ev := range e.AsValueMap() {
+				attrs[k] = ev
+			}
+		}
+	}
+	for k, hv := range h.attributes() {
+		if _, ok := attrs[k]; ok {
+			attrs[k] = cty.StringVal(hv)
+		}
+	}
+
+	vm := v.AsValueMap()
+	vm["scheduler_hints"] = cty.SetVal([]cty.Value{cty.ObjectVal(attrs)})
+
+	return cty.ObjectVal(vm)
+}
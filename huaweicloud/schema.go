@@ -0,0 +1,30 @@
+package huaweicloud
+
+import (
+	"encoding/json"
+
+	tfhuaweicloud "github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/pkg/errors"
+)
+
+// ResourceSchema returns the JSON schema that the embedded TF Provider
+// exposes for the resource type t, which are the attributes the reader
+// can populate on the HCL generated for it
+func ResourceSchema(t string) ([]byte, error) {
+	rt, err := ResourceTypeString(t)
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := tfhuaweicloud.Provider().ResourcesMap[string(rt)]
+	if !ok {
+		return nil, errors.Errorf("the resource type %q is not on the TF Provider %s", rt, version)
+	}
+
+	b, err := json.MarshalIndent(res.CoreConfigSchema(), "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to marshal the schema of the resource type %q", rt)
+	}
+
+	return b, nil
+}
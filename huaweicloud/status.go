@@ -0,0 +1,131 @@
+package huaweicloud
+
+import (
+	"strings"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// statusAttributes are the attributes the TF Provider uses for the
+// status of the resources, by the number of resource types that have
+// them. The status is the one of the service API, so their values differ
+// per service (ex: 'ACTIVE' or 'SHUTOFF' for the ECS instances,
+// 'available' or 'in-use' for the EVS volumes, 'OK' for the VPCs and
+// 'available' for the Dedicated Hosts, which have a 'state') and some
+// of them are codes (ex: 5 for the running CPH servers)
+var statusAttributes = []string{"status", "state"}
+
+// resourceStatus returns the status of the resource v, which
+// is false if the type has none or its value is empty
+func resourceStatus(v cty.Value) (string, bool) {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return "", false
+	}
+
+	for _, k := range statusAttributes {
+		if !v.Type().HasAttribute(k) {
+			continue
+		}
+
+		av := v.GetAttr(k)
+		if av.IsNull() || !av.IsKnown() {
+			continue
+		}
+
+		switch av.Type() {
+		case cty.String:
+			if s := av.AsString(); s != "" {
+				return s, true
+			}
+		case cty.Number:
+			return av.AsBigFloat().Text('f', -1), true
+		}
+	}
+
+	return "", false
+}
+
+// matchStatuses checks that the status of the resource v of type rt,
+// once read, is one of the Options.Statuses. The resources without
+// status always match it. The ones with a listed status were already
+// checked before being read, by the filterStatuses
+func matchStatuses(p *huaweicloudProvider, rt string, v cty.Value) error {
+	if len(p.options.Statuses) == 0 {
+		return nil
+	}
+
+	if _, ok := listedStatus(p, rt, valueID(v)); ok {
+		return nil
+	}
+
+	s, ok := resourceStatus(v)
+	if !ok || isStatusMatching(p, s) {
+		return nil
+	}
+
+	return errors.WithStack(errcode.ErrProviderResourceDoNotMatchStatus)
+}
+
+// isStatusMatching checks that the status s is one of the Options.Statuses,
+// case-insensitively as each service has its own case
+func isStatusMatching(p *huaweicloudProvider, s string) bool {
+	for _, ms := range p.options.Statuses {
+		if strings.EqualFold(s, ms) {
+			return true
+		}
+	}
+	return false
+}
+
+// setListedStatus records the status of the resource id of type
+// rt, as listed by its API, so the resources that do not have one
+// of the Options.Statuses are filtered before they are read (see
+// filterStatuses). The empty statuses are not recorded
+func setListedStatus(p *huaweicloudProvider, rt, id, status string) {
+	if len(p.options.Statuses) == 0 || status == "" {
+		return
+	}
+
+	p.listedStatusesMu.Lock()
+	defer p.listedStatusesMu.Unlock()
+
+	if p.listedStatuses == nil {
+		p.listedStatuses = make(map[string]string)
+	}
+	p.listedStatuses[rt+"."+id] = status
+}
+
+// listedStatus returns the status of the resource id of type
+// rt listed by its API, false if none was recorded
+func listedStatus(p *huaweicloudProvider, rt, id string) (string, bool) {
+	p.listedStatusesMu.Lock()
+	defer p.listedStatusesMu.Unlock()
+
+	s, ok := p.listedStatuses[rt+"."+id]
+	return s, ok
+}
+
+// filterStatuses returns the resources rs of type rt without the ones
+// which listed status is not one of the Options.Statuses, so they are
+// not read, the skipped ones are logged. The cache keeps all of them
+// so their children are still listed. The resources without listed
+// status are checked once read, by the matchStatuses
+func filterStatuses(p *huaweicloudProvider, rt string, rs []provider.Resource) []provider.Resource {
+	if len(p.options.Statuses) == 0 {
+		return rs
+	}
+
+	frs := make([]provider.Resource, 0, len(rs))
+	for _, r := range rs {
+		if s, ok := listedStatus(p, rt, r.ID()); ok && !isStatusMatching(p, s) {
+			logSkipped(p, rt, r.ID(), errcode.ErrProviderResourceDoNotMatchStatus.Error())
+			continue
+		}
+		frs = append(frs, r)
+	}
+
+	return frs
+}
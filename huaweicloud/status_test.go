@@ -0,0 +1,141 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatuses(t *testing.T) {
+	resource := func(k string, v cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id": cty.StringVal("res-1"),
+			k:    v,
+		})
+	}
+
+	tcs := []struct {
+		Name     string
+		Type     ResourceType
+		Value    cty.Value
+		Statuses []string
+		Err      error
+	}{
+		{
+			Name:     "EVSVolume",
+			Type:     EVSVolume,
+			Value:    resource("status", cty.StringVal("in-use")),
+			Statuses: []string{"available", "in-use"},
+		},
+		{
+			Name:     "EVSVolumeOtherStatus",
+			Type:     EVSVolume,
+			Value:    resource("status", cty.StringVal("error")),
+			Statuses: []string{"available", "in-use"},
+			Err:      errcode.ErrProviderResourceDoNotMatchStatus,
+		},
+		{
+			Name:     "DeHHostCaseInsensitive",
+			Type:     DeHHost,
+			Value:    resource("state", cty.StringVal("available")),
+			Statuses: []string{"AVAILABLE"},
+		},
+		{
+			Name:     "DeHHostOtherStatus",
+			Type:     DeHHost,
+			Value:    resource("state", cty.StringVal("fault")),
+			Statuses: []string{"ACTIVE", "available"},
+			Err:      errcode.ErrProviderResourceDoNotMatchStatus,
+		},
+		{
+			Name:     "Code",
+			Type:     CPHServer,
+			Value:    resource("status", cty.NumberIntVal(5)),
+			Statuses: []string{"5"},
+		},
+		{
+			Name:     "WithoutStatus",
+			Type:     VPCSubnet,
+			Value:    resource("name", cty.StringVal("res")),
+			Statuses: []string{"ACTIVE"},
+		},
+		{
+			Name:     "EmptyStatus",
+			Type:     EVSVolume,
+			Value:    resource("status", cty.StringVal("")),
+			Statuses: []string{"ACTIVE"},
+		},
+		{
+			Name:  "Disabled",
+			Type:  EVSVolume,
+			Value: resource("status", cty.StringVal("error")),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl = gomock.NewController(t)
+				r    = mock.NewHuaweicloudReader(ctrl)
+				p    = newTestProvider(t, r)
+			)
+			defer ctrl.Finish()
+
+			p.options.Statuses = tc.Statuses
+
+			_, err := p.FixResource(string(tc.Type), tc.Value)
+			if tc.Err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.Err)
+		})
+	}
+
+	t.Run("Listed", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.Statuses = []string{"available"}
+
+		r.EXPECT().ListEVSVolumes(ctx, reader.Page{}).Return([]reader.EVSVolume{
+			{ID: "vol-1", Status: "available"},
+			{ID: "vol-2", Status: "in-use"},
+			{ID: "vol-3"},
+		}, "", nil)
+
+		// The ones not matching are not returned, so they are not read
+		rs, err := p.Resources(ctx, string(EVSVolume), &filter.Filter{})
+		require.NoError(t, err)
+		ids := make([]string, 0, len(rs))
+		for _, r := range rs {
+			ids = append(ids, r.ID())
+		}
+		assert.Equal(t, []string{"vol-1", "vol-3"}, ids)
+
+		// The ones without listed status are checked once read
+		_, err = p.FixResource(string(EVSVolume), cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal("vol-3"),
+			"status": cty.StringVal("error"),
+		}))
+		assert.ErrorIs(t, err, errcode.ErrProviderResourceDoNotMatchStatus)
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.Error(t, Options{Statuses: []string{"ACTIVE", " "}}.validate())
+		assert.NoError(t, Options{Statuses: []string{"ACTIVE"}}.validate())
+	})
+}
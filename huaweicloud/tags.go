@@ -0,0 +1,241 @@
+package huaweicloud
+
+import (
+	"strings"
+
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+var (
+	// serverSystemMetadata are the keys of the ECS instance
+	// metadata set by Huawei Cloud which are not tags
+	serverSystemMetadata = map[string]struct{}{
+		"charging_mode":               {},
+		"vpc_id":                      {},
+		"image_name":                  {},
+		"os_bit":                      {},
+		"os_type":                     {},
+		"virtual_env_type":            {},
+		"agency_name":                 {},
+		"lockCheckEndpoint":           {},
+		"lockScene":                   {},
+		"lockSource":                  {},
+		"lockSourceId":                {},
+		"cascaded.instance_extrainfo": {},
+	}
+
+	// serverSystemMetadataPrefixes are the prefixes of the keys
+	// of the ECS instance metadata set by Huawei Cloud
+	serverSystemMetadataPrefixes = []string{"metering.", "__"}
+)
+
+// serverTags merges the tags of the tag service and the metadata
+// of the ECS instance s, the tag service takes precedence when
+// both have the same key. The system metadata is ignored
+func serverTags(s reader.Server) map[string]string {
+	tags := make(map[string]string)
+
+	for k, v := range s.Metadata {
+		if isServerSystemMetadata(k) {
+			continue
+		}
+		tags[k] = v
+	}
+
+	for _, t := range s.Tags {
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) == 1 {
+			tags[kv[0]] = ""
+		} else {
+			tags[kv[0]] = kv[1]
+		}
+	}
+
+	return tags
+}
+
+// isServerSystemMetadata checks if the metadata key k is one set by Huawei Cloud
+func isServerSystemMetadata(k string) bool {
+	if _, ok := serverSystemMetadata[k]; ok {
+		return true
+	}
+	for _, p := range serverSystemMetadataPrefixes {
+		if strings.HasPrefix(k, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// fixComputeInstanceTags replaces the 'tags' of the instance v
+// with the merged ones of the tag service and the metadata
+func fixComputeInstanceTags(p *huaweicloudProvider, v cty.Value) (cty.Value, error) {
+	if v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute("id") || !v.Type().HasAttribute("tags") {
+		return v, nil
+	}
+
+	id := v.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return v, nil
+	}
+
+	p.instanceTagsMu.Lock()
+	tags, ok := p.instanceTags[id.AsString()]
+	p.instanceTagsMu.Unlock()
+	if !ok || len(tags) == 0 {
+		return v, nil
+	}
+
+	vtags := make(map[string]cty.Value, len(tags))
+	for k, t := range tags {
+		vtags[k] = cty.StringVal(t)
+	}
+
+	vm := v.AsValueMap()
+	vm["tags"] = cty.MapVal(vtags)
+
+	return cty.ObjectVal(vm), nil
+}
+
+// fixResourceTags normalizes the tags of the resource v of type t to
+// the map the TF schema expects, as some services return them as a
+// list of '{key, value}' objects instead. The tags in any other
+// shape are kept as they are
+func fixResourceTags(p *huaweicloudProvider, t string, v cty.Value) cty.Value {
+	k := p.TagKeyForResource(t)
+	if k == "" || v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(k) {
+		return v
+	}
+
+	tv := v.GetAttr(k)
+	if tv.IsNull() || !tv.IsWhollyKnown() {
+		return v
+	}
+
+	tt := tv.Type()
+	if !tt.IsListType() && !tt.IsSetType() && !tt.IsTupleType() {
+		return v
+	}
+
+	vtags := make(map[string]cty.Value)
+	for it := tv.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+		key, value, ok := tagKeyValue(e)
+		if !ok {
+			return v
+		}
+		vtags[key] = cty.StringVal(value)
+	}
+
+	vm := v.AsValueMap()
+	if len(vtags) == 0 {
+		vm[k] = cty.NullVal(cty.Map(cty.String))
+	} else {
+		vm[k] = cty.MapVal(vtags)
+	}
+
+	return cty.ObjectVal(vm)
+}
+
+// tagKeyValue returns the 'key' and 'value' of the tag e,
+// which is an object or a map of strings
+func tagKeyValue(e cty.Value) (string, string, bool) {
+	if e.IsNull() || (!e.Type().IsObjectType() && !e.Type().IsMapType()) {
+		return "", "", false
+	}
+
+	em := e.AsValueMap()
+	key, ok := em["key"]
+	if !ok || key.IsNull() || !key.Type().Equals(cty.String) || key.AsString() == "" {
+		return "", "", false
+	}
+
+	value, ok := em["value"]
+	if !ok || value.IsNull() {
+		return key.AsString(), "", true
+	}
+	if !value.Type().Equals(cty.String) {
+		return "", "", false
+	}
+
+	return key.AsString(), value.AsString(), true
+}
+
+// TagTransform renames the keys of the tags of the ECS instances
+// on the HCL and the state, to follow a label convention
+type TagTransform struct {
+	// Renames are the new keys of the tags, indexed by their
+	// key. The renamed tags replace the ones with the new key
+	Renames map[string]string
+
+	// Prefix, if defined, is prepended to the keys
+	// of the tags that are not renamed
+	Prefix string
+}
+
+// validate checks that the TagTransform has no empty
+// keys and that no two tags are renamed to the same key
+func (tt TagTransform) validate() error {
+	keys := make(map[string]string, len(tt.Renames))
+	for k, nk := range tt.Renames {
+		if k == "" || nk == "" {
+			return errors.Errorf("invalid rename of %q to %q, the keys can not be empty", k, nk)
+		}
+		if dup, ok := keys[nk]; ok {
+			return errors.Errorf("invalid renames, %q and %q are both renamed to %q", dup, k, nk)
+		}
+		keys[nk] = k
+	}
+
+	return nil
+}
+
+// isEmpty checks if the TagTransform does not change any key
+func (tt TagTransform) isEmpty() bool {
+	return len(tt.Renames) == 0 && tt.Prefix == ""
+}
+
+// key returns the new key of the tag key k,
+// which is true if the tag is renamed
+func (tt TagTransform) key(k string) (string, bool) {
+	if nk, ok := tt.Renames[k]; ok {
+		return nk, true
+	}
+	return tt.Prefix + k, false
+}
+
+// fixComputeInstanceTagTransform renames the keys of the 'tags' of the
+// instance v with the Options.TagTransform. It runs after the tags are
+// merged so the keys of the metadata are also renamed
+func fixComputeInstanceTagTransform(p *huaweicloudProvider, v cty.Value) cty.Value {
+	if p.options.TagTransform.isEmpty() || v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute("tags") {
+		return v
+	}
+
+	tags := v.GetAttr("tags")
+	if tags.IsNull() || !tags.IsWhollyKnown() || !tags.Type().IsMapType() || tags.LengthInt() == 0 {
+		return v
+	}
+
+	// The renamed tags are set last so
+	// they replace the ones with their key
+	tm := tags.AsValueMap()
+	vtags := make(map[string]cty.Value, len(tm))
+	for k, tv := range tm {
+		if nk, ok := p.options.TagTransform.key(k); !ok {
+			vtags[nk] = tv
+		}
+	}
+	for k, tv := range tm {
+		if nk, ok := p.options.TagTransform.key(k); ok {
+			vtags[nk] = tv
+		}
+	}
+
+	vm := v.AsValueMap()
+	vm["tags"] = cty.MapVal(vtags)
+
+	return cty.ObjectVal(vm)
+}
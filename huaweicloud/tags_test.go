@@ -0,0 +1,148 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixResourceTags(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	tagObject := func(k, v string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"key":   cty.StringVal(k),
+			"value": cty.StringVal(v),
+		})
+	}
+	normalized := cty.MapVal(map[string]cty.Value{
+		"env":   cty.StringVal("prod"),
+		"owner": cty.StringVal("cycloid"),
+	})
+
+	t.Run("List", func(t *testing.T) {
+		v, err := p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("vpc-1"),
+			"tags": cty.ListVal([]cty.Value{tagObject("env", "prod"), tagObject("owner", "cycloid")}),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, normalized, v.GetAttr("tags"))
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		v, err := p.FixResource(string(EVSVolume), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("volume-1"),
+			"tags": cty.SetVal([]cty.Value{tagObject("env", "prod"), tagObject("owner", "cycloid")}),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, normalized, v.GetAttr("tags"))
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		v, err := p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("vpc-1"),
+			"tags": normalized,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, normalized, v.GetAttr("tags"))
+	})
+
+	t.Run("EmptyList", func(t *testing.T) {
+		v, err := p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("vpc-1"),
+			"tags": cty.ListValEmpty(cty.Object(map[string]cty.Type{"key": cty.String, "value": cty.String})),
+		}))
+		require.NoError(t, err)
+		assert.True(t, v.GetAttr("tags").IsNull())
+	})
+
+	t.Run("UnknownShape", func(t *testing.T) {
+		tags := cty.ListVal([]cty.Value{cty.StringVal("env=prod")})
+		v, err := p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("vpc-1"),
+			"tags": tags,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, tags, v.GetAttr("tags"))
+	})
+
+	t.Run("NoTags", func(t *testing.T) {
+		assert.Equal(t, "tags", p.TagKeyForResource(string(VPC)))
+		assert.Equal(t, "", p.TagKeyForResource(string(ModelArtsNotebook)))
+
+		tags := cty.ListVal([]cty.Value{tagObject("env", "prod")})
+		v, err := p.FixResource(string(ModelArtsNotebook), cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("notebook-1"),
+			"tags": tags,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, tags, v.GetAttr("tags"))
+	})
+}
+
+func TestFixComputeInstanceTagTransform(t *testing.T) {
+	instance := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.StringVal("ecs-1"),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"Env":        cty.StringVal("prod"),
+			"env":        cty.StringVal("stale"),
+			"CostCenter": cty.StringVal("42"),
+			"team":       cty.StringVal("web"),
+		}),
+	})
+
+	tcs := []struct {
+		Name      string
+		Transform TagTransform
+		Tags      cty.Value
+	}{
+		{
+			Name: "Rename",
+			Transform: TagTransform{
+				Renames: map[string]string{"Env": "env", "CostCenter": "cost_center"},
+			},
+			// The renamed tag replaces the one with its key
+			Tags: cty.MapVal(map[string]cty.Value{
+				"env":         cty.StringVal("prod"),
+				"cost_center": cty.StringVal("42"),
+				"team":        cty.StringVal("web"),
+			}),
+		},
+		{
+			Name: "RenameAndPrefix",
+			Transform: TagTransform{
+				Renames: map[string]string{"Env": "env"},
+				Prefix:  "hw_",
+			},
+			Tags: cty.MapVal(map[string]cty.Value{
+				"env":           cty.StringVal("prod"),
+				"hw_env":        cty.StringVal("stale"),
+				"hw_CostCenter": cty.StringVal("42"),
+				"hw_team":       cty.StringVal("web"),
+			}),
+		},
+		{
+			Name: "Disabled",
+			Tags: instance.GetAttr("tags"),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			p := newTestProvider(t, nil)
+			p.options.TagTransform = tc.Transform
+
+			v, err := p.FixResource(string(ComputeInstance), instance)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Tags, v.GetAttr("tags"))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, TagTransform{Renames: map[string]string{"Env": "env"}, Prefix: "hw_"}.validate())
+		assert.Error(t, TagTransform{Renames: map[string]string{"Env": ""}}.validate())
+		assert.Error(t, TagTransform{Renames: map[string]string{"Env": "env", "ENV": "env"}}.validate())
+	})
+}
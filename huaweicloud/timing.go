@@ -0,0 +1,51 @@
+package huaweicloud
+
+import (
+	"sort"
+	"time"
+)
+
+// recordReaderDuration records the wall-clock duration d of the reader
+// of the resourceType, the durations of a type read more than once add up
+func (p *huaweicloudProvider) recordReaderDuration(resourceType string, d time.Duration) {
+	p.readerDurationsMu.Lock()
+	defer p.readerDurationsMu.Unlock()
+
+	if p.readerDurations == nil {
+		p.readerDurations = make(map[string]time.Duration)
+	}
+	p.readerDurations[resourceType] += d
+}
+
+func (p *huaweicloudProvider) ReaderDurations() map[string]time.Duration {
+	p.readerDurationsMu.Lock()
+	defer p.readerDurationsMu.Unlock()
+
+	durations := make(map[string]time.Duration, len(p.readerDurations))
+	for t, d := range p.readerDurations {
+		durations[t] = d
+	}
+
+	return durations
+}
+
+// logReaderDurations logs the duration of the reader of
+// each resource type read, from the slowest to the fastest
+func (p *huaweicloudProvider) logReaderDurations() {
+	durations := p.ReaderDurations()
+
+	types := make([]string, 0, len(durations))
+	for t := range durations {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if durations[types[i]] != durations[types[j]] {
+			return durations[types[i]] > durations[types[j]]
+		}
+		return types[i] < types[j]
+	})
+
+	for _, t := range types {
+		readerLogger(p, t).Log("func", "huaweicloud.logReaderDurations", "msg", "reader duration", "duration", durations[t].String())
+	}
+}
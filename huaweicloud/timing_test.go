@@ -0,0 +1,73 @@
+package huaweicloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderDurations(t *testing.T) {
+	types := []string{string(VPC), string(SMNTopic)}
+
+	tcs := []struct {
+		Name    string
+		Timings bool
+	}{
+		{Name: "WithTimings", Timings: true},
+		{Name: "WithoutTimings"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				ctrl   = gomock.NewController(t)
+				r      = mock.NewHuaweicloudReader(ctrl)
+				p      = newTestProvider(t, r)
+				ctx    = context.Background()
+				logger = &capturingLogger{}
+			)
+			defer ctrl.Finish()
+
+			p.logger = logger
+			p.options.Timings = tc.Timings
+
+			r.EXPECT().ListVPCs(gomock.Any(), reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}}, "", nil)
+			r.EXPECT().ListSMNTopics(gomock.Any(), reader.Page{}).Return([]reader.SMNTopic{{URN: "urn:smn:cn-north-1:123456:ops"}}, "", nil)
+
+			_, err := p.ResourcesBatch(ctx, types, &filter.Filter{})
+			require.NoError(t, err)
+
+			// The durations are always recorded
+			durations := p.ReaderDurations()
+			require.Len(t, durations, len(types))
+			for _, rt := range types {
+				assert.Contains(t, durations, rt)
+			}
+
+			summary := make(map[string]interface{})
+			for _, l := range logger.lines {
+				if l["msg"] == "resources read" {
+					assert.NotEmpty(t, l["duration"])
+				}
+				if l["msg"] == "reader duration" {
+					summary[l["resource"].(string)] = l["duration"]
+				}
+			}
+
+			if !tc.Timings {
+				assert.Empty(t, summary)
+				return
+			}
+			require.Len(t, summary, len(types))
+			for _, rt := range types {
+				assert.Equal(t, durations[rt].String(), summary[rt])
+			}
+		})
+	}
+}
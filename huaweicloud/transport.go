@@ -0,0 +1,36 @@
+package huaweicloud
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// setProxy makes all the HTTP clients of the cfg use the proxy.
+// The TF Provider already uses the HTTPS_PROXY/HTTP_PROXY/NO_PROXY
+// environment variables, so this is only needed for an explicit proxy
+func setProxy(cfg *config.Config, proxy *url.URL) {
+	for _, c := range []*golangsdk.ProviderClient{cfg.HwClient, cfg.DomainClient} {
+		if c == nil {
+			continue
+		}
+
+		if t := httpTransport(c.HTTPClient.Transport); t != nil {
+			t.Proxy = http.ProxyURL(proxy)
+		}
+	}
+}
+
+// httpTransport returns the *http.Transport used by rt, which
+// can be wrapped by the *config.LogRoundTripper of the TF Provider
+func httpTransport(rt http.RoundTripper) *http.Transport {
+	switch t := rt.(type) {
+	case *http.Transport:
+		return t
+	case *config.LogRoundTripper:
+		return httpTransport(t.Rt)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package huaweicloud
+
+import (
+	"regexp"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/pkg/errors"
+)
+
+// TypeFilter filters the resources of only one type,
+// it's applied on top of the filters of the import
+type TypeFilter struct {
+	// Tags are the tags the resources must have
+	Tags []tag.Tag
+
+	// Name, if defined, is the regexp the 'name' of the resources
+	// must match, the types without 'name' never match it
+	Name *regexp.Regexp
+
+	// Max, if defined, is the maximum number of resources
+	// listed of the type, before the Tags and Name are checked
+	Max int
+}
+
+// validate checks that the values of the TypeFilter are valid
+func (tf TypeFilter) validate() error {
+	if tf.Max < 0 {
+		return errors.Errorf("invalid max %d, it can not be negative", tf.Max)
+	}
+
+	return nil
+}
+
+// truncate truncates the rs to the TypeFilter.Max
+func (tf TypeFilter) truncate(rs []provider.Resource) []provider.Resource {
+	if tf.Max > 0 && len(rs) > tf.Max {
+		return rs[:tf.Max]
+	}
+	return rs
+}
+
+// maxPerType returns the maximum number of resources of the type rt
+// to list, the lowest of the filter f and the TypeFilter.Max of rt,
+// it's 0 if there is no limit
+func maxPerType(p *huaweicloudProvider, f *filter.Filter, rt string) int {
+	max := f.MaxPerType
+	if tf, ok := p.options.TypeFilters[rt]; ok && tf.Max > 0 && (max == 0 || tf.Max < max) {
+		max = tf.Max
+	}
+	return max
+}
+
+// match checks that the resource v, once read, matches the
+// TypeFilter, the errors are the same as the ones of the
+// tags filter so the resource is skipped by the import
+func (tf TypeFilter) match(v cty.Value) error {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return nil
+	}
+
+	if tf.Name != nil {
+		if !v.Type().HasAttribute("name") {
+			return errors.WithStack(errcode.ErrProviderResourceDoNotMatchName)
+		}
+
+		name := v.GetAttr("name")
+		if name.IsNull() || !name.IsKnown() || !tf.Name.MatchString(name.AsString()) {
+			return errors.WithStack(errcode.ErrProviderResourceDoNotMatchName)
+		}
+	}
+
+	if len(tf.Tags) != 0 {
+		if !v.Type().HasAttribute("tags") {
+			return errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag)
+		}
+
+		tags := v.GetAttr("tags")
+		if tags.IsNull() || !tags.IsKnown() || !tags.CanIterateElements() {
+			return errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag)
+		}
+
+		tm := tags.AsValueMap()
+		for _, t := range tf.Tags {
+			tv, ok := tm[t.Name]
+			if !ok || tv.IsNull() || !tv.IsKnown() || tv.AsString() != t.Value {
+				return errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package huaweicloud
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/huaweicloud/reader"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/tag"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeFilterMatch(t *testing.T) {
+	instance := func(name string, tags map[string]cty.Value) cty.Value {
+		tv := cty.NullVal(cty.Map(cty.String))
+		if len(tags) != 0 {
+			tv = cty.MapVal(tags)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"tags": tv,
+		})
+	}
+
+	tf := TypeFilter{
+		Tags: []tag.Tag{{Name: "env", Value: "prod"}},
+		Name: regexp.MustCompile("^web-"),
+	}
+
+	tcs := []struct {
+		Name  string
+		Value cty.Value
+		Err   error
+	}{
+		{
+			Name:  "Match",
+			Value: instance("web-1", map[string]cty.Value{"env": cty.StringVal("prod"), "team": cty.StringVal("web")}),
+		},
+		{
+			Name:  "NameNotMatching",
+			Value: instance("db-1", map[string]cty.Value{"env": cty.StringVal("prod")}),
+			Err:   errcode.ErrProviderResourceDoNotMatchName,
+		},
+		{
+			Name:  "TagNotMatching",
+			Value: instance("web-1", map[string]cty.Value{"env": cty.StringVal("dev")}),
+			Err:   errcode.ErrProviderResourceDoNotMatchTag,
+		},
+		{
+			Name:  "Untagged",
+			Value: instance("web-1", nil),
+			Err:   errcode.ErrProviderResourceDoNotMatchTag,
+		},
+		{
+			Name:  "WithoutName",
+			Value: cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("1")}),
+			Err:   errcode.ErrProviderResourceDoNotMatchName,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tf.match(tc.Value)
+			if tc.Err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.Err)
+		})
+	}
+}
+
+func TestTypeFilters(t *testing.T) {
+	t.Run("Max", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			ctx  = context.Background()
+		)
+		defer ctrl.Finish()
+
+		p.options.TypeFilters = map[string]TypeFilter{string(VPC): {Max: 1}}
+
+		r.EXPECT().ListVPCs(ctx, reader.Page{}).Return([]reader.VPC{{ID: "vpc-1"}, {ID: "vpc-2"}}, "", nil)
+
+		rs, err := p.Resources(ctx, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "vpc-1", rs[0].ID())
+
+		// The cache keeps all the VPCs so
+		// they can be referenced
+		vpcIDs, err := getVPCIDs(ctx, p, string(VPC), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, vpcIDs, 2)
+	})
+
+	t.Run("FixResource", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		p.options.TypeFilters = map[string]TypeFilter{string(VPC): {Name: regexp.MustCompile("^prod-")}}
+
+		_, err := p.FixResource(string(VPC), cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("dev-1")}))
+		assert.ErrorIs(t, err, errcode.ErrProviderResourceDoNotMatchName)
+
+		// The other types are not filtered
+		_, err = p.FixResource(string(VPCSubnet), cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("dev-1")}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("FixResourceTagTransform", func(t *testing.T) {
+		p := newTestProvider(t, nil)
+		p.options.TagTransform = TagTransform{Renames: map[string]string{"Env": "env"}}
+
+		instance := cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("i-1"),
+			"name": cty.StringVal("web-1"),
+			"tags": cty.MapVal(map[string]cty.Value{"Env": cty.StringVal("prod")}),
+		})
+
+		// The tags are matched with the transformed keys
+		p.options.TypeFilters = map[string]TypeFilter{string(ComputeInstance): {Tags: []tag.Tag{{Name: "env", Value: "prod"}}}}
+		_, err := p.FixResource(string(ComputeInstance), instance)
+		assert.NoError(t, err)
+
+		p.options.TypeFilters = map[string]TypeFilter{string(ComputeInstance): {Tags: []tag.Tag{{Name: "Env", Value: "prod"}}}}
+		_, err = p.FixResource(string(ComputeInstance), instance)
+		assert.ErrorIs(t, err, errcode.ErrProviderResourceDoNotMatchTag)
+	})
+
+	t.Run("InvalidOptions", func(t *testing.T) {
+		err := Options{TypeFilters: map[string]TypeFilter{"huaweicloud_unknown": {}}}.validate()
+		assert.Error(t, err)
+
+		err = Options{TypeFilters: map[string]TypeFilter{string(VPC): {Max: -1}}}.validate()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package huaweicloud
+
+import (
+	"strings"
+
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+// hclAttributeKeyPrefix is the prefix of the keys of the TypeMap
+// attributes on the HCL configuration of the provider.Resource
+const hclAttributeKeyPrefix = "=tc="
+
+// ValidateResource validates the HCL configuration cfg of the resource
+// r against the schema of the embedded TF Provider, if the
+// Options.ValidateHCL is enabled, so the resources that would fail on
+// the plan (ex: a required attribute that is not read) are reported
+func (p *huaweicloudProvider) ValidateResource(r provider.Resource, cfg map[string]interface{}) error {
+	if !p.options.ValidateHCL {
+		return nil
+	}
+
+	res, ok := p.tfProvider.ResourcesMap[r.Type()]
+	if !ok {
+		return nil
+	}
+
+	diags := res.Validate(terraform.NewResourceConfigRaw(validationConfig(res.Schema, cfg)))
+	if !diags.HasError() {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			msgs = append(msgs, d.Summary+": "+d.Detail)
+		} else {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// validationConfig returns the HCL configuration cfg as the TF Provider
+// expects it with the schema sch, without the hclAttributeKeyPrefix.
+// The required attributes that were not read are set to their zero
+// value on the HCL, they are removed so they are reported as not set
+func validationConfig(sch map[string]*schema.Schema, cfg map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(cfg))
+	for k, v := range cfg {
+		k = strings.TrimPrefix(k, hclAttributeKeyPrefix)
+
+		s, ok := sch[k]
+		if !ok {
+			res[k] = v
+			continue
+		}
+
+		if v == nil || (s.Required && s.Type == schema.TypeString && v == "") {
+			continue
+		}
+
+		er, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			res[k] = v
+			continue
+		}
+
+		switch vv := v.(type) {
+		case map[string]interface{}:
+			res[k] = validationConfig(er.Schema, vv)
+		case []interface{}:
+			l := make([]interface{}, 0, len(vv))
+			for _, e := range vv {
+				if m, ok := e.(map[string]interface{}); ok {
+					l = append(l, validationConfig(er.Schema, m))
+				}
+			}
+			res[k] = l
+		default:
+			res[k] = v
+		}
+	}
+
+	return res
+}
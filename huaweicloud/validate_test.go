@@ -0,0 +1,91 @@
+package huaweicloud
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResource(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+		)
+		defer ctrl.Finish()
+
+		p.options.ValidateHCL = true
+
+		err := p.ValidateResource(provider.NewResource("vpc-1", string(VPC), p), map[string]interface{}{
+			"name":     "vpc",
+			"cidr":     "192.168.0.0/16",
+			"=tc=tags": map[string]interface{}{"env": "prod"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+		)
+		defer ctrl.Finish()
+
+		p.options.ValidateHCL = true
+
+		err := p.ValidateResource(provider.NewResource("vpc-1", string(VPC), p), map[string]interface{}{
+			"name": "",
+			"cidr": "not-a-cidr",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `The argument "name" is required`)
+		assert.Contains(t, err.Error(), "not-a-cidr")
+	})
+
+	t.Run("HCL", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+			w    = mock.NewWriter(ctrl)
+		)
+		defer ctrl.Finish()
+
+		p.options.ValidateHCL = true
+
+		// The 'name' is not read, so it's written
+		// empty which does not satisfy the schema
+		res := provider.NewResource("vpc-1", string(VPC), p)
+		require.NoError(t, res.Data().Set("cidr", "192.168.0.0/16"))
+
+		w.EXPECT().Has(gomock.Any()).Return(false, nil)
+		w.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := res.HCL(w)
+		assert.True(t, errors.Is(err, errcode.ErrProviderResourceInvalidConfig))
+		assert.Contains(t, err.Error(), "huaweicloud_vpc.")
+		assert.Contains(t, err.Error(), `The argument "name" is required`)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewHuaweicloudReader(ctrl)
+			p    = newTestProvider(t, r)
+		)
+		defer ctrl.Finish()
+
+		err := p.ValidateResource(provider.NewResource("vpc-1", string(VPC), p), map[string]interface{}{
+			"cidr": "not-a-cidr",
+		})
+		assert.NoError(t, err)
+	})
+}
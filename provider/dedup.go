@@ -0,0 +1,55 @@
+package provider
+
+// resourceKey identifies a Resource by its type and ID,
+// the Resources with the same key are the same resource
+type resourceKey struct {
+	rtype string
+	id    string
+}
+
+// keyedResource is a Resource with its resourceKey
+type keyedResource struct {
+	Resource
+
+	key resourceKey
+}
+
+// deduplicate returns the rs of the type t with their keys and without
+// the duplicated ones, as the same resource may be listed by many readers
+// of the Provider. Of the duplicates the most complete one is kept, on the
+// position of the first one so the order of the rs does not change
+func deduplicate(t string, rs []Resource) []keyedResource {
+	krs := make([]keyedResource, 0, len(rs))
+	idx := make(map[resourceKey]int, len(rs))
+	for _, r := range rs {
+		k := resourceKey{rtype: t, id: r.ID()}
+		if i, ok := idx[k]; ok {
+			if completeness(r) > completeness(krs[i].Resource) {
+				krs[i].Resource = r
+			}
+			continue
+		}
+
+		idx[k] = len(krs)
+		krs = append(krs, keyedResource{Resource: r, key: k})
+	}
+
+	return krs
+}
+
+// completeness is the number of attributes of the Resource r, from its
+// InstanceState if it has one or from the Data set by the readers if not
+func completeness(r Resource) int {
+	if s := r.InstanceState(); s != nil {
+		return len(s.Attributes)
+	}
+
+	var n int
+	for k := range r.TFResource().Schema {
+		if _, ok := r.Data().GetOk(k); ok {
+			n++
+		}
+	}
+
+	return n
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	kitlog "github.com/go-kit/kit/log"
 
@@ -68,9 +69,45 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 
 	interpolation := interpolator.New(p.String())
 
+	var batch map[string][]Resource
+	if br, ok := p.(BatchReader); ok && typesWithIDs == nil {
+		included := make([]string, 0, len(types))
+		for _, t := range types {
+			if !f.IsExcluded(t) {
+				included = append(included, t)
+			}
+		}
+
+		logger.Log("msg", "fetching the list of resources of all the types")
+		batch, err = br.ResourcesBatch(ctx, included, f)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	// Once interrupted no more resources are read
+	// but the ones read until then are written
+	var interrupted bool
+
+	// The resources with an invalid HCL are written
+	// and reported once all of them are imported
+	var invalid []string
+
+	summary := newImportSummary()
+
+	// The resources imported, as the ones imported with another
+	// Resource may also be listed by the reader of their type
+	imported := make(map[resourceKey]struct{})
+
+types:
 	for _, t := range types {
 		logger := kitlog.With(logger, "resource", t)
 
+		if IsInterrupted(ctx) {
+			interrupted = true
+			break
+		}
+
 		if f.IsExcluded(t) {
 			logger.Log("msg", "excluded")
 			continue
@@ -78,12 +115,17 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 
 		logger.Log("msg", "fetching the list of resources")
 
+		ts := summary.get(t)
+		start := time.Now()
+
 		var resources []Resource
 
 		if typesWithIDs != nil {
 			for _, ID := range typesWithIDs[t] {
 				resources = append(resources, NewResource(ID, t, p))
 			}
+		} else if batch != nil {
+			resources = batch[t]
 		} else {
 			resources, err = p.Resources(ctx, t, f)
 			if err != nil {
@@ -91,17 +133,35 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 				// the import but we print the error.
 				if errors.Is(err, errcode.ErrProviderAPI) {
 					logger.Log("msg", fmt.Sprintf("unable to import resource %s: %s\n", t, err.Error()))
+					ts.errors++
 				} else {
 					return errors.WithStack(err)
 				}
 			}
 		}
 
-		resourceLen := len(resources)
-		for i, re := range resources {
-			logger := kitlog.With(logger, "id", re.ID(), "total", resourceLen, "current", i+1)
+		krs := deduplicate(t, resources)
+		if n := len(resources) - len(krs); n != 0 {
+			logger.Log("msg", "duplicated resources removed", "count", n)
+		}
+
+		resourceLen := len(krs)
+		for i, re := range krs {
+			if IsInterrupted(ctx) {
+				fmt.Fprintf(out, "\rImporting %s [%d/%d] Interrupted!\n", t, i, resourceLen)
+				ts.duration += time.Since(start)
+				interrupted = true
+				break types
+			}
+
+			logger := kitlog.With(logger, "id", re.key.id, "total", resourceLen, "current", i+1)
 			fmt.Fprintf(out, "\rImporting %s [%d/%d]", t, i+1, resourceLen)
 
+			if _, ok := imported[re.key]; ok {
+				logger.Log("msg", "already imported")
+				continue
+			}
+
 			logger.Log("msg", "reading from TF")
 			res, err := re.ImportState()
 			if err != nil {
@@ -118,21 +178,40 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 			// In case there is more than one State to import
 			// we create a new slice with those elements and iterate
 			// over it
-			for _, r := range append([]Resource{re}, res...) {
+			for j, r := range append([]Resource{re.Resource}, res...) {
+				key := re.key
+				if j != 0 {
+					key = resourceKey{rtype: r.Type(), id: r.ID()}
+					if _, ok := imported[key]; ok {
+						continue
+					}
+				}
+
 				err = util.RetryDefault(func() error { return r.Read(f) })
 				if err != nil {
 					// Errors are ignored. If a resource is invalid we assume it can be skipped, it can be related to inconsistencies in deployed resources.
 					// So instead of failing and stopping execution we ignore them and continue (we log them if -v is specified)
 
 					logger.Log("error", err)
+					if isFiltered(err) {
+						ts.skipped++
+					} else {
+						ts.errors++
+					}
 
 					continue
 				}
 
+				ts.count++
+				imported[key] = struct{}{}
+
 				if hcl != nil {
 					logger.Log("msg", "calculating HCL")
 					err = r.HCL(hcl)
-					if err != nil {
+					if errors.Is(err, errcode.ErrProviderResourceInvalidConfig) {
+						logger.Log("msg", "invalid HCL", "error", err)
+						invalid = append(invalid, err.Error())
+					} else if err != nil {
 						return errors.Wrapf(err, "error while calculating the Config of resource %q", t)
 					}
 				}
@@ -166,9 +245,16 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		if resourceLen > 0 {
 			fmt.Fprintf(out, "\rImporting %s [%d/%d] Done!\n", t, resourceLen, resourceLen)
 		}
+		ts.duration += time.Since(start)
 		logger.Log("msg", "importing done")
 	}
 
+	// The batched types were listed before, which
+	// is only known by the readers of the Provider
+	if rt, ok := p.(ReaderTimer); ok && batch != nil {
+		summary.addReaderDurations(rt.ReaderDurations())
+	}
+
 	if hcl != nil {
 		hcl.Interpolate(interpolation)
 		fmt.Fprintf(out, "\rWriting HCL ...")
@@ -183,6 +269,13 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		logger.Log("msg", "writing the HCL done")
 	}
 
+	if len(invalid) != 0 {
+		fmt.Fprintf(out, "The HCL of %d resources does not satisfy the schema of the provider:\n", len(invalid))
+		for _, i := range invalid {
+			fmt.Fprintf(out, "  * %s\n", i)
+		}
+	}
+
 	if tfstate != nil {
 		tfstate.Interpolate(interpolation)
 		fmt.Fprintf(out, "\rWriting TFState ...")
@@ -197,5 +290,33 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		logger.Log("msg", "writing the TFState done")
 	}
 
+	summary.log(logger)
+	summary.write(out)
+
+	if interrupted {
+		logger.Log("msg", "import interrupted")
+		return errors.WithStack(errcode.ErrImportInterrupted)
+	}
+
 	return nil
 }
+
+// filteredErrors are the errors of the resources that are filtered
+// out on purpose when read, which are not an error of the import
+var filteredErrors = []error{
+	errcode.ErrProviderResourceDoNotMatchTag,
+	errcode.ErrProviderResourceDoNotMatchName,
+	errcode.ErrProviderResourceAutogenerated,
+	errcode.ErrProviderResourceCreatedBefore,
+	errcode.ErrProviderResourceDoNotMatchStatus,
+}
+
+// isFiltered checks if the err is one of the filteredErrors
+func isFiltered(err error) bool {
+	for _, ferr := range filteredErrors {
+		if errors.Is(err, ferr) {
+			return true
+		}
+	}
+	return false
+}
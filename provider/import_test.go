@@ -1,10 +1,13 @@
 package provider_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
@@ -334,6 +337,69 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, nil, f, ioutil.Discard)
 		require.NoError(t, err)
 	})
+	t.Run("SuccessWithDuplicates", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			p            = mock.NewProvider(ctrl)
+			hw           = mock.NewWriter(ctrl)
+			sg           = mock.NewResource(ctrl)
+			sgDuplicated = mock.NewResource(ctrl)
+			sgRule1      = mock.NewResource(ctrl)
+			sgRule1Dup   = mock.NewResource(ctrl)
+			sgRule2      = mock.NewResource(ctrl)
+			i            = interpolator.New("aws")
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().ResourceTypes().Return([]string{"aws_security_group", "aws_security_group_rule"})
+
+		// The same security group is listed twice and
+		// only the most complete one is imported
+		p.EXPECT().Resources(ctx, "aws_security_group", f).Return([]provider.Resource{sg, sgDuplicated}, nil)
+
+		sg.EXPECT().ID().Return("sg-1")
+		sgDuplicated.EXPECT().ID().Return("sg-1")
+
+		sg.EXPECT().InstanceState().Return(&terraform.InstanceState{Attributes: map[string]string{"id": "sg-1"}})
+		sgDuplicated.EXPECT().InstanceState().Return(&terraform.InstanceState{Attributes: map[string]string{"id": "sg-1", "name": "web"}})
+
+		// The rule imported with the security group
+		// is not imported again from its reader
+		sgDuplicated.EXPECT().ImportState().Return([]provider.Resource{sgRule1}, nil)
+		sgDuplicated.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		sgDuplicated.EXPECT().Read(f).Return(nil)
+		sgDuplicated.EXPECT().HCL(hw).Return(nil)
+		sgDuplicated.EXPECT().InstanceState().Return(nil)
+
+		sgRule1.EXPECT().Type().Return("aws_security_group_rule")
+		sgRule1.EXPECT().ID().Return("sgr-1")
+		sgRule1.EXPECT().Read(f).Return(nil)
+		sgRule1.EXPECT().HCL(hw).Return(nil)
+		sgRule1.EXPECT().InstanceState().Return(nil)
+
+		p.EXPECT().Resources(ctx, "aws_security_group_rule", f).Return([]provider.Resource{sgRule1Dup, sgRule2}, nil)
+
+		sgRule1Dup.EXPECT().ID().Return("sgr-1")
+		sgRule2.EXPECT().ID().Return("sgr-2")
+
+		sgRule2.EXPECT().ImportState().Return(nil, nil)
+		sgRule2.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		sgRule2.EXPECT().Read(f).Return(nil)
+		sgRule2.EXPECT().HCL(hw).Return(nil)
+		sgRule2.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, nil, f, ioutil.Discard)
+		require.NoError(t, err)
+	})
 	t.Run("ErrorWithErrProviderResourceNotRead", func(t *testing.T) {
 		var (
 			ctrl = gomock.NewController(t)
@@ -557,4 +623,335 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
 		require.NoError(t, err)
 	})
+	t.Run("Interrupted", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			interrupt = make(chan struct{})
+			ctx       = provider.WithInterrupt(context.Background(), interrupt)
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			i                 = interpolator.New("aws")
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user"})
+
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		// The import is interrupted while reading the first resource,
+		// which is written, and the rest are not read
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource2.EXPECT().ID().Return("2")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).DoAndReturn(func(*filter.Filter) error {
+			close(interrupt)
+			return nil
+		})
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().State(sw).Return(nil)
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
+		assert.True(t, errors.Is(err, errcode.ErrImportInterrupted))
+	})
+
+	t.Run("InvalidConfig", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			i                 = interpolator.New("aws")
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			out               = &bytes.Buffer{}
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance"})
+
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		// The invalid resource is reported and
+		// the next ones are still imported
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource1.EXPECT().HCL(hw).Return(fmt.Errorf("%w: aws_instance.front: missing ami", errcode.ErrProviderResourceInvalidConfig))
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		instanceResource2.EXPECT().ID().Return("2")
+		instanceResource2.EXPECT().ImportState().Return(nil, nil)
+		instanceResource2.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource2.EXPECT().Read(f).Return(nil)
+		instanceResource2.EXPECT().HCL(hw).Return(nil)
+		instanceResource2.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, nil, f, out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "The HCL of 1 resources does not satisfy the schema of the provider:\n  * the resource configuration does not satisfy the schema: aws_instance.front: missing ami\n")
+	})
+}
+
+// batchProvider is a mock.Provider that
+// implements the provider.BatchReader
+type batchProvider struct {
+	*mock.Provider
+
+	types []string
+	batch map[string][]provider.Resource
+}
+
+func (p *batchProvider) ResourcesBatch(ctx context.Context, types []string, f *filter.Filter) (map[string][]provider.Resource, error) {
+	p.types = types
+	return p.batch, nil
+}
+
+func TestImportBatchReader(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		ctx  = context.Background()
+
+		mp       = mock.NewProvider(ctrl)
+		hw       = mock.NewWriter(ctrl)
+		i        = interpolator.New("aws")
+		instance = mock.NewResource(ctrl)
+
+		f = &filter.Filter{
+			Exclude: []string{"aws_iam_user"},
+		}
+		p = &batchProvider{
+			Provider: mp,
+			batch:    map[string][]provider.Resource{"aws_instance": {instance}},
+		}
+	)
+
+	defer ctrl.Finish()
+
+	mp.EXPECT().String().Return("aws")
+	mp.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user", "aws_s3_bucket"})
+	mp.EXPECT().HasResourceType("aws_iam_user").Return(true)
+
+	instance.EXPECT().ID().Return("1")
+	instance.EXPECT().ImportState().Return(nil, nil)
+	instance.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+	instance.EXPECT().Read(f).Return(nil)
+	instance.EXPECT().HCL(hw).Return(nil)
+	instance.EXPECT().InstanceState().Return(nil)
+
+	hw.EXPECT().Sync().Return(nil)
+	hw.EXPECT().Interpolate(i)
+
+	err := provider.Import(ctx, p, hw, nil, f, ioutil.Discard)
+	require.NoError(t, err)
+
+	// The excluded types are not read and the
+	// Resources are never called
+	assert.Equal(t, []string{"aws_instance", "aws_s3_bucket"}, p.types)
+}
+
+// timedBatchProvider is a batchProvider that
+// implements the provider.ReaderTimer
+type timedBatchProvider struct {
+	*batchProvider
+
+	durations map[string]time.Duration
+}
+
+func (p *timedBatchProvider) ReaderDurations() map[string]time.Duration {
+	return p.durations
+}
+
+func TestImportSummary(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+			out  = &bytes.Buffer{}
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			i                 = interpolator.New("aws")
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			instanceResource3 = mock.NewResource(ctrl)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user", "aws_s3_bucket"})
+
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2, instanceResource3}, nil)
+		p.EXPECT().Resources(ctx, "aws_iam_user", f).Return(nil, errors.Wrap(errcode.ErrProviderAPI, "throttled"))
+		p.EXPECT().Resources(ctx, "aws_s3_bucket", f).Return(nil, nil)
+
+		for n, ir := range []*mock.Resource{instanceResource1, instanceResource2, instanceResource3} {
+			ir.EXPECT().ID().Return(fmt.Sprint(n + 1)).AnyTimes()
+			ir.EXPECT().ImportState().Return(nil, nil)
+			ir.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		}
+
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource2.EXPECT().Read(f).Return(nil)
+		instanceResource3.EXPECT().Read(f).Return(errors.New("not found"))
+
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource2.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+		instanceResource2.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, nil, f, out)
+		require.NoError(t, err)
+
+		summary := out.String()[strings.Index(out.String(), "Summary:\n"):]
+		lines := strings.Split(strings.TrimSpace(summary), "\n")
+		require.Len(t, lines, 6)
+		assert.Equal(t, []string{"TYPE", "COUNT", "DURATION", "ERRORS", "SKIPPED"}, strings.Fields(lines[1]))
+
+		rows := make(map[string][]string)
+		for _, l := range lines[2:] {
+			fs := strings.Fields(l)
+			rows[fs[0]] = []string{fs[1], fs[3]}
+		}
+		assert.Equal(t, map[string][]string{
+			"aws_instance":  {"2", "1"},
+			"aws_iam_user":  {"0", "1"},
+			"aws_s3_bucket": {"0", "0"},
+			"TOTAL":         {"2", "2"},
+		}, rows)
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+			out  = &bytes.Buffer{}
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			i                 = interpolator.New("aws")
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			instanceResource3 = mock.NewResource(ctrl)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance"})
+
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2, instanceResource3}, nil)
+
+		for n, ir := range []*mock.Resource{instanceResource1, instanceResource2, instanceResource3} {
+			ir.EXPECT().ID().Return(fmt.Sprint(n + 1)).AnyTimes()
+			ir.EXPECT().ImportState().Return(nil, nil)
+			ir.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		}
+
+		// The resources filtered out on purpose are
+		// skipped, they are not errors of the import
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource2.EXPECT().Read(f).Return(errors.Wrap(errors.WithStack(errcode.ErrProviderResourceDoNotMatchTag), "failed to fix resource aws_instance"))
+		instanceResource3.EXPECT().Read(f).Return(errors.WithStack(errcode.ErrProviderResourceAutogenerated))
+
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, nil, f, out)
+		require.NoError(t, err)
+
+		summary := out.String()[strings.Index(out.String(), "Summary:\n"):]
+		lines := strings.Split(strings.TrimSpace(summary), "\n")
+		require.Len(t, lines, 4)
+
+		fs := strings.Fields(lines[2])
+		assert.Equal(t, "aws_instance", fs[0])
+		assert.Equal(t, "1", fs[1])
+		assert.Equal(t, "0", fs[3])
+		assert.Equal(t, "2", fs[4])
+	})
+
+	t.Run("ReaderDurations", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+			out  = &bytes.Buffer{}
+
+			mp       = mock.NewProvider(ctrl)
+			hw       = mock.NewWriter(ctrl)
+			i        = interpolator.New("aws")
+			instance = mock.NewResource(ctrl)
+
+			f = &filter.Filter{}
+			p = &timedBatchProvider{
+				batchProvider: &batchProvider{
+					Provider: mp,
+					batch:    map[string][]provider.Resource{"aws_instance": {instance}},
+				},
+				durations: map[string]time.Duration{"aws_instance": time.Hour},
+			}
+		)
+
+		defer ctrl.Finish()
+
+		mp.EXPECT().String().Return("aws")
+		mp.EXPECT().ResourceTypes().Return([]string{"aws_instance"})
+
+		instance.EXPECT().ID().Return("1")
+		instance.EXPECT().ImportState().Return(nil, nil)
+		instance.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instance.EXPECT().Read(f).Return(nil)
+		instance.EXPECT().HCL(hw).Return(nil)
+		instance.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, nil, f, out)
+		require.NoError(t, err)
+
+		var row []string
+		for _, l := range strings.Split(out.String(), "\n") {
+			if fs := strings.Fields(l); len(fs) == 5 && fs[0] == "aws_instance" {
+				row = fs
+			}
+		}
+		require.NotNil(t, row)
+		assert.Equal(t, "1", row[1])
+		assert.True(t, strings.HasPrefix(row[2], "1h0m0"), row[2])
+	})
 }
@@ -0,0 +1,28 @@
+package provider
+
+import "context"
+
+type interruptKey struct{}
+
+// WithInterrupt returns a copy of ctx for an Import that is interrupted
+// once the interrupt is closed. Unlike a canceled ctx the interrupted
+// one lets the in-flight reads finish, the Import stops reading new
+// resources and writes the ones read so far
+func WithInterrupt(ctx context.Context, interrupt <-chan struct{}) context.Context {
+	return context.WithValue(ctx, interruptKey{}, interrupt)
+}
+
+// IsInterrupted checks if the Import of the ctx was interrupted
+func IsInterrupted(ctx context.Context) bool {
+	interrupt, ok := ctx.Value(interruptKey{}).(<-chan struct{})
+	if !ok {
+		return false
+	}
+
+	select {
+	case <-interrupt:
+		return true
+	default:
+		return false
+	}
+}
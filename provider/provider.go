@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/hashicorp/go-cty/cty"
@@ -68,3 +69,36 @@ type Provider interface {
 	// like instances in autoscaling
 	FilterByTags(tags interface{}) error
 }
+
+// ResourceCategorizer is an optional interface of the Provider
+// to choose the category of each Resource, which is the file
+// in which it's written on the HCL. If the Provider does not
+// implement it or it returns false the category of the docs
+// of the resource type is used
+type ResourceCategorizer interface {
+	ResourceCategory(r Resource) (string, bool)
+}
+
+// ResourceValidator is an optional interface of the Provider to
+// validate the HCL configuration cfg of each Resource against the
+// schema of its type. The Resources that do not satisfy it are
+// still written, and reported at the end of the Import
+type ResourceValidator interface {
+	ValidateResource(r Resource, cfg map[string]interface{}) error
+}
+
+// BatchReader is an optional interface of the Provider to read
+// many resource types at once (ex: concurrently). If the Provider
+// implements it, the Import reads all the types with it instead of
+// calling Resources for each one of them
+type BatchReader interface {
+	ResourcesBatch(ctx context.Context, types []string, f *filter.Filter) (map[string][]Resource, error)
+}
+
+// ReaderTimer is an optional interface of the Provider to know the
+// wall-clock duration of the reader of each resource type, indexed
+// by type. It's added to the durations on the summary of the Import
+// for the types read at once with the BatchReader
+type ReaderTimer interface {
+	ReaderDurations() map[string]time.Duration
+}
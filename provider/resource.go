@@ -384,22 +384,54 @@ func (r *resource) State(w writer.Writer) error {
 	return nil
 }
 
-// HCL returns the HCL configuration of the Resource and
-// writes it to HCL
-func (r *resource) HCL(w writer.Writer) error {
-	cfg := mergeFullConfig(r.data, r.tfResource.Schema, "")
+// category returns the category of the Resource, the one chosen by the
+// Provider if it's a ResourceCategorizer or the one of the docs. For the
+// providers that are not present in tfdocs yet it's empty, so the
+// default one of the writer is used
+func (r *resource) category() (string, error) {
+	if rc, ok := r.provider.(ResourceCategorizer); ok {
+		if c, ok := rc.ResourceCategory(r); ok {
+			return c, nil
+		}
+	}
 
 	resourceFunc, ok := providerResources[r.provider.String()]
 	if !ok {
-		return errors.New(fmt.Sprintf("provider %s is not supported", r.provider.String()))
+		// Fallback to the default category for providers that are not present in tfdocs yet,
+		// as the AttributesReference does with the schema
+		return "", nil
 	}
 
 	tfdoc, err := resourceFunc(r.Type())
 	if err != nil {
-		return errors.New(fmt.Sprintf("provider %s with resource %s is not supported on the docs", r.provider.String(), r.Type()))
+		return "", errors.New(fmt.Sprintf("provider %s with resource %s is not supported on the docs", r.provider.String(), r.Type()))
 	}
+
 	// This will convert all Category into snake_case
-	cfg[writer.ResourceCategoryKey] = strings.ToLower(name.Delimit(tfdoc.Category, '_'))
+	return strings.ToLower(name.Delimit(tfdoc.Category, '_')), nil
+}
+
+// HCL returns the HCL configuration of the Resource and
+// writes it to HCL, if the Provider is a ResourceValidator
+// and the configuration is invalid it's written and an
+// errcode.ErrProviderResourceInvalidConfig is returned
+func (r *resource) HCL(w writer.Writer) error {
+	cfg := mergeFullConfig(r.data, r.tfResource.Schema, "")
+
+	// The Resource is written even if it's invalid,
+	// so it can be fixed from the generated HCL
+	var verr error
+	if rv, ok := r.provider.(ResourceValidator); ok {
+		verr = rv.ValidateResource(r, cfg)
+	}
+
+	category, err := r.category()
+	if err != nil {
+		return err
+	}
+	if category != "" {
+		cfg[writer.ResourceCategoryKey] = category
+	}
 
 	// If it does not have any configName we will generate one
 	// and store it, so net time it'll use that one on any config
@@ -424,6 +456,10 @@ func (r *resource) HCL(w writer.Writer) error {
 		}
 	}
 
+	if verr != nil {
+		return fmt.Errorf("%w: %s.%s: %v", errcode.ErrProviderResourceInvalidConfig, r.resourceType, r.configName, verr)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+)
+
+// typeSummary is the result of the Import of a resource type, the
+// skipped are the resources filtered out on purpose when read
+type typeSummary struct {
+	count    int
+	errors   int
+	skipped  int
+	duration time.Duration
+}
+
+// importSummary is the result of the Import of each resource
+// type, which is written at the end of it so the user can check
+// at a glance what was imported
+type importSummary struct {
+	types   []string
+	byTypes map[string]*typeSummary
+}
+
+func newImportSummary() *importSummary {
+	return &importSummary{
+		byTypes: make(map[string]*typeSummary),
+	}
+}
+
+// get returns the typeSummary of the resource type t,
+// the types are kept on the order they were first got
+func (s *importSummary) get(t string) *typeSummary {
+	ts, ok := s.byTypes[t]
+	if !ok {
+		ts = &typeSummary{}
+		s.byTypes[t] = ts
+		s.types = append(s.types, t)
+	}
+	return ts
+}
+
+// addReaderDurations adds the durations of the readers, indexed by
+// type, to the types of the summary as they are read before them
+func (s *importSummary) addReaderDurations(durations map[string]time.Duration) {
+	for _, t := range s.types {
+		s.byTypes[t].duration += durations[t]
+	}
+}
+
+// log logs the summary of each type on the logger,
+// as the one written is not visible with the logs
+func (s *importSummary) log(logger kitlog.Logger) {
+	for _, t := range s.types {
+		ts := s.byTypes[t]
+		logger.Log("resource", t, "msg", "summary", "count", ts.count, "duration", ts.duration.String(), "errors", ts.errors, "skipped", ts.skipped)
+	}
+}
+
+// write writes the summary as a table to out,
+// nothing is written if no type was imported
+func (s *importSummary) write(out io.Writer) {
+	if len(s.types) == 0 {
+		return
+	}
+
+	var total typeSummary
+
+	fmt.Fprintf(out, "Summary:\n")
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "TYPE\tCOUNT\tDURATION\tERRORS\tSKIPPED\n")
+	for _, t := range s.types {
+		ts := s.byTypes[t]
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%d\n", t, ts.count, ts.duration.Round(time.Millisecond), ts.errors, ts.skipped)
+
+		total.count += ts.count
+		total.errors += ts.errors
+		total.skipped += ts.skipped
+		total.duration += ts.duration
+	}
+	fmt.Fprintf(tw, "TOTAL\t%d\t%s\t%d\t%d\n", total.count, total.duration.Round(time.Millisecond), total.errors, total.skipped)
+	tw.Flush()
+}
@@ -17,6 +17,11 @@ type Options struct {
 	// means use all attributes as variables
 	ModuleVariables map[string]struct{}
 
+	// ModuleWithoutVariables writes the Module without
+	// any variable, so the resources keep the references
+	// between them as they are all inside of it
+	ModuleWithoutVariables bool
+
 	// HCLProviderBlock make the HCL generate or not the
 	// 'provider "" {}' block
 	HCLProviderBlock bool